@@ -4,6 +4,8 @@ import (
 	"context"
 	msggate "insight/internal/msg-gate"
 	"insight/pkg/common/config"
+	"insight/pkg/common/token"
+	msg_rpc "insight/pkg/proto/msg"
 	"net"
 	"os"
 	"runtime"
@@ -25,7 +27,10 @@ func main() {
 		fx.Provide(newLogger),
 		fx.Provide(newConfig),
 		fx.Provide(msggate.NewWsServer),
+		fx.Provide(msggate.NewTcpServer),
+		fx.Provide(msggate.NewGateRpc),
 		fx.Provide(newValidator),
+		fx.Provide(newVerifier),
 		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
 			//optional 使得fx框架里的日志输出到指定的logger
 			return &fxevent.ZapLogger{Logger: log}
@@ -34,7 +39,7 @@ func main() {
 	).Run()
 }
 
-func Server(lc fx.Lifecycle, log *zap.Logger, cfg *config.GateConfig, wsSvr *msggate.WsServer, validate *validator.Validate) {
+func Server(lc fx.Lifecycle, log *zap.Logger, cfg *config.GateConfig, wsSvr *msggate.WsServer, tcpSvr *msggate.TcpServer, gateRpc *msggate.GateRpc, validate *validator.Validate) {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	lc.Append(
 		fx.Hook{
@@ -44,8 +49,12 @@ func Server(lc fx.Lifecycle, log *zap.Logger, cfg *config.GateConfig, wsSvr *msg
 					go func() {
 						wsSvr.StartWs()
 					}()
+					//启动tcp
+					go func() {
+						tcpSvr.StartTcp()
+					}()
 					//启动rpc
-					startRpc(log)
+					startRpc(log, cfg, gateRpc)
 				}()
 				return nil
 			},
@@ -56,7 +65,7 @@ func Server(lc fx.Lifecycle, log *zap.Logger, cfg *config.GateConfig, wsSvr *msg
 		})
 }
 
-func startRpc(log *zap.Logger) {
+func startRpc(log *zap.Logger, cfg *config.GateConfig, gateRpc *msggate.GateRpc) {
 	keepParams := grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle:     time.Duration(time.Second * 60),
 		MaxConnectionAgeGrace: time.Duration(time.Second * 20),
@@ -66,12 +75,14 @@ func startRpc(log *zap.Logger) {
 	})
 	server := grpc.NewServer(keepParams)
 	defer server.GracefulStop()
-	listen, err := net.Listen("tcp", ":7748")
+	msg_rpc.RegisterGateServer(server, gateRpc)
+	address := ":" + cfg.RpcCfg.Port
+	listen, err := net.Listen("tcp", address)
 	if err != nil {
 		panic("listening err:" + err.Error())
 	}
 	defer listen.Close()
-	log.Info("msg-gate rpc listen success", zap.String("address", ":7748"))
+	log.Info("msg-gate rpc listen success", zap.String("address", address))
 	err = server.Serve(listen)
 	if err != nil {
 		log.Error("rpc listening err", zap.String("err", err.Error()))
@@ -112,3 +123,16 @@ func newValidator() *validator.Validate {
 	validate := validator.New()
 	return validate
 }
+
+func newVerifier(cfg *config.GateConfig) (token.Verifier, error) {
+	v, err := token.NewVerifier(cfg.TokenCfg)
+	if err != nil {
+		return nil, err
+	}
+	//token服务在消息服务中，用于确认token未被吊销
+	clientConn, err := grpc.Dial(cfg.RpcCfg.MsgAddr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return msggate.NewRevocableVerifier(v, msg_rpc.NewTokenClient(clientConn)), nil
+}
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"insight/internal/conversation"
+	"insight/internal/friend"
+	"insight/internal/group"
 	"insight/internal/msg"
+	"insight/internal/receipt"
+	"insight/pkg/common/config"
 	msg_rpc "insight/pkg/proto/msg"
 	"net"
 	"os"
 	"runtime"
 	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/natefinch/lumberjack"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -20,19 +26,33 @@ import (
 func main() {
 	fx.New(
 		fx.Provide(newLogger),
+		fx.Provide(newConfig),
+		fx.Provide(msg.NewTokenServer),
+		fx.Provide(func(cfg *config.MsgConfig) (group.Store, error) { return group.NewStore(cfg.GroupCfg) }),
+		fx.Provide(func(s group.Store) group.Membership { return s }),
+		fx.Provide(func(cfg *config.MsgConfig) (friend.Store, error) { return friend.NewStore(cfg.FriendCfg) }),
+		fx.Provide(func(s friend.Store) friend.Relation { return s }),
+		fx.Provide(func(cfg *config.MsgConfig) (receipt.Store, error) { return receipt.NewStore(cfg.ReceiptCfg) }),
+		fx.Provide(func(cfg *config.MsgConfig) (conversation.Store, error) {
+			return conversation.NewStore(cfg.ConversationCfg)
+		}),
+		fx.Provide(func(s conversation.Store) conversation.RecvOpts { return s }),
 		fx.Provide(msg.NewChatServer),
+		fx.Provide(msg.NewGroupServer),
+		fx.Provide(msg.NewFriendServer),
+		fx.Provide(msg.NewConversationServer),
 		fx.Invoke(Server),
 	).Run()
 }
 
-func Server(lc fx.Lifecycle, log *zap.Logger, chat *msg.Chat) {
+func Server(lc fx.Lifecycle, log *zap.Logger, cfg *config.MsgConfig, chat *msg.Chat, token *msg.Token, group *msg.Group, friend *msg.Friend, conversation *msg.Conversation) {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	lc.Append(
 		fx.Hook{
 			OnStart: func(context.Context) error {
 				go func() {
 					//启动服务
-					startRpc(log, chat)
+					startRpc(log, cfg, chat, token, group, friend, conversation)
 				}()
 				return nil
 			},
@@ -43,7 +63,7 @@ func Server(lc fx.Lifecycle, log *zap.Logger, chat *msg.Chat) {
 		})
 }
 
-func startRpc(log *zap.Logger, chat *msg.Chat) {
+func startRpc(log *zap.Logger, cfg *config.MsgConfig, chat *msg.Chat, token *msg.Token, group *msg.Group, friend *msg.Friend, conversation *msg.Conversation) {
 	keepParams := grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle:     time.Duration(time.Second * 60),
 		MaxConnectionAgeGrace: time.Duration(time.Second * 20),
@@ -54,12 +74,17 @@ func startRpc(log *zap.Logger, chat *msg.Chat) {
 	server := grpc.NewServer(keepParams)
 	defer server.GracefulStop()
 	msg_rpc.RegisterChatServer(server, chat)
-	listen, err := net.Listen("tcp", ":7749")
+	msg_rpc.RegisterTokenServer(server, token)
+	msg_rpc.RegisterGroupServer(server, group)
+	msg_rpc.RegisterFriendServer(server, friend)
+	msg_rpc.RegisterConversationServer(server, conversation)
+	address := ":" + cfg.RpcCfg.Port
+	listen, err := net.Listen("tcp", address)
 	if err != nil {
 		panic("listening err:" + err.Error())
 	}
 	defer listen.Close()
-	log.Info("msg rpc listen success", zap.String("address", ":7749"))
+	log.Info("msg rpc listen success", zap.String("address", address))
 
 	err = server.Serve(listen)
 	if err != nil {
@@ -67,6 +92,14 @@ func startRpc(log *zap.Logger, chat *msg.Chat) {
 	}
 }
 
+func newConfig() *config.MsgConfig {
+	var cfg config.MsgConfig
+	if _, err := toml.DecodeFile("../../configs/msg/msg.toml", &cfg); err != nil {
+		panic(err)
+	}
+	return &cfg
+}
+
 func newLogger() (*zap.Logger, error) {
 	//return zap.NewProduction()
 	//获取编码器,NewJSONEncoder()输出json格式，NewConsoleEncoder()输出普通文本格式
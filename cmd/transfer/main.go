@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"insight/internal/push"
+	"insight/internal/transfer"
+	"insight/pkg/common/config"
+	"os"
+	"runtime"
+
+	"github.com/BurntSushi/toml"
+	"github.com/natefinch/lumberjack"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func main() {
+	fx.New(
+		fx.Provide(newLogger),
+		fx.Provide(newConfig),
+		fx.Provide(push.NewService),
+		fx.Provide(transfer.NewMsgTransfer),
+		fx.Invoke(Server),
+	).Run()
+}
+
+func Server(lc fx.Lifecycle, log *zap.Logger, t *transfer.MsgTransfer) {
+	runtime.GOMAXPROCS(runtime.NumCPU())
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				go func() {
+					//启动kafka消费
+					log.Info("transfer start consume")
+					t.Start()
+				}()
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				log.Info("server exiting")
+				return nil
+			},
+		})
+}
+
+func newConfig() *config.TransferConfig {
+	var cfg config.TransferConfig
+	if _, err := toml.DecodeFile("../../configs/transfer/transfer.toml", &cfg); err != nil {
+		panic(err)
+	}
+	return &cfg
+}
+
+func newLogger() (*zap.Logger, error) {
+	//获取编码器,NewJSONEncoder()输出json格式，NewConsoleEncoder()输出普通文本格式
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder //指定时间格式
+	encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	encoder := zapcore.NewConsoleEncoder(encoderConfig)
+
+	//文件writeSyncer
+	fileWriteSyncer := zapcore.AddSync(&lumberjack.Logger{
+		Filename:   "./logs/transfer.log", //日志文件存放目录
+		MaxSize:    10,                    //文件大小限制,单位MB
+		MaxBackups: 20,                    //最大保留日志文件数量
+		MaxAge:     30,                    //日志文件保留天数
+		Compress:   false,                 //是否压缩处理
+	})
+	fileCore := zapcore.NewCore(encoder, zapcore.NewMultiWriteSyncer(fileWriteSyncer, zapcore.AddSync(os.Stdout)), zapcore.DebugLevel) //第三个及之后的参数为写入文件的日志级别,ErrorLevel模式只记录error级别的日志
+
+	logger := zap.New(fileCore, zap.AddCaller()) //AddCaller()为显示文件名和行号
+	return logger, nil
+}
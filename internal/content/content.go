@@ -0,0 +1,233 @@
+package content
+
+import (
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+	"math"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	//文本的最大长度，单位字符
+	maxTextLen = 4096
+	//@的最大人数
+	maxAtUserNum = 100
+	//合并转发的最大消息数
+	maxMergerMsgNum = 100
+)
+
+// 各消息类型content校验失败时的错误码
+const (
+	ErrCodeUnknownContentType = 229
+	ErrCodeText               = 230
+	ErrCodePicture            = 231
+	ErrCodeVoice              = 232
+	ErrCodeVideo              = 233
+	ErrCodeFile               = 234
+	ErrCodeAtText             = 235
+	ErrCodeMerger             = 236
+	ErrCodeCard               = 237
+	ErrCodeLocation           = 238
+	ErrCodeCustom             = 239
+	ErrCodeRevoke             = 240
+	ErrCodeReadReceipt        = 241
+	ErrCodeTyping             = 242
+	ErrCodeQuote              = 243
+	//@的用户不在群中
+	ErrCodeAtUserNotInGroup = 244
+	//引用的消息不存在、已撤回或不属于当前会话
+	ErrCodeQuoteMsg = 245
+	//合并转发的消息不存在或已撤回
+	ErrCodeMergerMsg = 246
+)
+
+type schema struct {
+	errCode int32
+	newMsg  func() proto.Message
+	//校验通过返回空字符串，否则返回错误信息
+	check func(m proto.Message) string
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// 用户消息各类型content的结构和校验规则
+var schemas = map[int32]schema{
+	constant.Text: {ErrCodeText, func() proto.Message { return &msg.TextContent{} }, func(m proto.Message) string {
+		return checkText(m.(*msg.TextContent).Text)
+	}},
+	constant.Picture: {ErrCodePicture, func() proto.Message { return &msg.PictureContent{} }, func(m proto.Message) string {
+		c := m.(*msg.PictureContent)
+		if c.SourcePicture == nil {
+			return "sourcePicture is empty"
+		}
+		for _, p := range []*msg.PictureBaseInfo{c.SourcePicture, c.BigPicture, c.SnapshotPicture} {
+			if p == nil {
+				continue
+			}
+			if p.Url == "" {
+				return "picture url is empty"
+			}
+			if p.Width <= 0 || p.Height <= 0 {
+				return "picture width or height err"
+			}
+			if p.Size < 0 {
+				return "picture size err"
+			}
+		}
+		return ""
+	}},
+	constant.Voice: {ErrCodeVoice, func() proto.Message { return &msg.VoiceContent{} }, func(m proto.Message) string {
+		c := m.(*msg.VoiceContent)
+		if c.SourceURL == "" {
+			return "sourceURL is empty"
+		}
+		if c.Duration <= 0 {
+			return "duration err"
+		}
+		return ""
+	}},
+	constant.Video: {ErrCodeVideo, func() proto.Message { return &msg.VideoContent{} }, func(m proto.Message) string {
+		c := m.(*msg.VideoContent)
+		if c.VideoURL == "" {
+			return "videoURL is empty"
+		}
+		if c.SnapshotURL == "" {
+			return "snapshotURL is empty"
+		}
+		if c.Duration <= 0 {
+			return "duration err"
+		}
+		return ""
+	}},
+	constant.File: {ErrCodeFile, func() proto.Message { return &msg.FileContent{} }, func(m proto.Message) string {
+		c := m.(*msg.FileContent)
+		if c.SourceURL == "" {
+			return "sourceURL is empty"
+		}
+		if c.FileName == "" {
+			return "fileName is empty"
+		}
+		if c.FileSize <= 0 {
+			return "fileSize err"
+		}
+		return ""
+	}},
+	constant.AtText: {ErrCodeAtText, func() proto.Message { return &msg.AtTextContent{} }, func(m proto.Message) string {
+		c := m.(*msg.AtTextContent)
+		if errMsg := checkText(c.Text); errMsg != "" {
+			return errMsg
+		}
+		if len(c.AtUserList) == 0 || len(c.AtUserList) > maxAtUserNum {
+			return "atUserList len err"
+		}
+		return ""
+	}},
+	constant.Merger: {ErrCodeMerger, func() proto.Message { return &msg.MergerContent{} }, func(m proto.Message) string {
+		c := m.(*msg.MergerContent)
+		if c.Title == "" {
+			return "title is empty"
+		}
+		if len(c.MultiMessage) == 0 || len(c.MultiMessage) > maxMergerMsgNum {
+			return "multiMessage len err"
+		}
+		for _, m := range c.MultiMessage {
+			if m == nil || m.ServerMsgID == "" {
+				return "multiMessage serverMsgID is empty"
+			}
+		}
+		return ""
+	}},
+	constant.Card: {ErrCodeCard, func() proto.Message { return &msg.CardContent{} }, func(m proto.Message) string {
+		if m.(*msg.CardContent).UserID == "" {
+			return "userID is empty"
+		}
+		return ""
+	}},
+	constant.Location: {ErrCodeLocation, func() proto.Message { return &msg.LocationContent{} }, func(m proto.Message) string {
+		c := m.(*msg.LocationContent)
+		//NaN和任何数比较都为false，需要单独判断
+		if !isFinite(c.Longitude) || !isFinite(c.Latitude) {
+			return "longitude or latitude is not finite"
+		}
+		if c.Longitude < -180 || c.Longitude > 180 {
+			return "longitude out of range"
+		}
+		if c.Latitude < -90 || c.Latitude > 90 {
+			return "latitude out of range"
+		}
+		return ""
+	}},
+	constant.Custom: {ErrCodeCustom, func() proto.Message { return &msg.CustomContent{} }, func(m proto.Message) string {
+		if m.(*msg.CustomContent).Data == "" {
+			return "data is empty"
+		}
+		return ""
+	}},
+	constant.Revoke: {ErrCodeRevoke, func() proto.Message { return &msg.RevokeContent{} }, func(m proto.Message) string {
+		if m.(*msg.RevokeContent).ServerMsgID == "" {
+			return "serverMsgID is empty"
+		}
+		return ""
+	}},
+	constant.HasReadReceipt: {ErrCodeReadReceipt, func() proto.Message { return &msg.ReadReceiptContent{} }, func(m proto.Message) string {
+		if m.(*msg.ReadReceiptContent).ServerMsgID == "" {
+			return "serverMsgID is empty"
+		}
+		return ""
+	}},
+	constant.Typing: {ErrCodeTyping, func() proto.Message { return &msg.TypingContent{} }, func(m proto.Message) string {
+		return ""
+	}},
+	constant.Quote: {ErrCodeQuote, func() proto.Message { return &msg.QuoteContent{} }, func(m proto.Message) string {
+		c := m.(*msg.QuoteContent)
+		if errMsg := checkText(c.Text); errMsg != "" {
+			return errMsg
+		}
+		if c.QuoteMessage == nil || c.QuoteMessage.ServerMsgID == "" {
+			return "quoteMessage serverMsgID is empty"
+		}
+		return ""
+	}},
+}
+
+func checkText(text string) string {
+	if text == "" {
+		return "text is empty"
+	}
+	if !utf8.ValidString(text) {
+		return "text is not utf8"
+	}
+	if utf8.RuneCountInString(text) > maxTextLen {
+		return "text too long"
+	}
+	return ""
+}
+
+// 校验用户消息的content，通过时errCode为0
+func Validate(data *msg.MsgData) (errCode int32, errMsg string) {
+	s, ok := schemas[data.ContentType]
+	if !ok {
+		return ErrCodeUnknownContentType, "unknown contentType"
+	}
+	m := s.newMsg()
+	if err := proto.Unmarshal(data.Content, m); err != nil {
+		return s.errCode, "unmarshal content err: " + err.Error()
+	}
+	if errMsg := s.check(m); errMsg != "" {
+		return s.errCode, errMsg
+	}
+	return 0, ""
+}
+
+// 用户消息content对应的protobuf结构，未知的消息类型返回nil
+func New(contentType int32) proto.Message {
+	s, ok := schemas[contentType]
+	if !ok {
+		return nil
+	}
+	return s.newMsg()
+}
@@ -0,0 +1,86 @@
+package conversation
+
+import (
+	"fmt"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"sync"
+)
+
+const (
+	//会话设置存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// 会话接收选项查询，消息服务投递消息时使用
+type RecvOpts interface {
+	//没有设置时为constant.ReceiveMessage
+	GetRecvOpt(userID, conversationID string) (int32, error)
+}
+
+// 会话设置存储
+type Store interface {
+	RecvOpts
+	SetRecvOpt(userID, conversationID string, opt int32) error
+	//用户设置过的所有会话的接收选项
+	GetAllRecvOpts(userID string) (map[string]int32, error)
+}
+
+func NewStore(cfg config.Conversation) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return NewFileStore(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported conversation backend: %s", cfg.Backend)
+}
+
+// 内存会话设置存储，重启后数据会丢失，只适合测试使用
+type memoryStore struct {
+	rwLock *sync.RWMutex
+	opts   map[string]map[string]int32 //用户id -> 会话id -> 接收选项
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		rwLock: new(sync.RWMutex),
+		opts:   make(map[string]map[string]int32),
+	}
+}
+
+func (s *memoryStore) GetRecvOpt(userID, conversationID string) (int32, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	opt, ok := s.opts[userID][conversationID]
+	if !ok {
+		return constant.ReceiveMessage, nil
+	}
+	return opt, nil
+}
+
+// 设置为正常接收时删除记录
+func (s *memoryStore) SetRecvOpt(userID, conversationID string, opt int32) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if opt == constant.ReceiveMessage {
+		delete(s.opts[userID], conversationID)
+		return nil
+	}
+	if _, ok := s.opts[userID]; !ok {
+		s.opts[userID] = make(map[string]int32)
+	}
+	s.opts[userID][conversationID] = opt
+	return nil
+}
+
+func (s *memoryStore) GetAllRecvOpts(userID string) (map[string]int32, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	opts := make(map[string]int32, len(s.opts[userID]))
+	for conversationID, opt := range s.opts[userID] {
+		opts[conversationID] = opt
+	}
+	return opts, nil
+}
@@ -0,0 +1,114 @@
+package conversation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"insight/pkg/common/constant"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 本地文件会话设置存储，每个用户一个文件，内容为 会话id -> 接收选项 的json
+// 内存中缓存已读取的用户，每次修改都会写回文件
+type fileStore struct {
+	dir   string
+	mutex sync.Mutex
+	opts  map[string]map[string]int32
+}
+
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, opts: make(map[string]map[string]int32)}, nil
+}
+
+// 用户id做hex编码避免出现路径分隔符
+func (s *fileStore) file(userID string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(userID)))
+}
+
+func (s *fileStore) get(userID string) (map[string]int32, error) {
+	if opts, ok := s.opts[userID]; ok {
+		return opts, nil
+	}
+	opts := make(map[string]int32)
+	data, err := os.ReadFile(s.file(userID))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &opts); err != nil {
+			return nil, fmt.Errorf("parse conversation file of %s: %w", userID, err)
+		}
+	}
+	s.opts[userID] = opts
+	return opts, nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (s *fileStore) save(userID string, opts map[string]int32) error {
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+	name := s.file(userID)
+	if err := os.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (s *fileStore) GetRecvOpt(userID, conversationID string) (int32, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	opts, err := s.get(userID)
+	if err != nil {
+		return constant.ReceiveMessage, err
+	}
+	opt, ok := opts[conversationID]
+	if !ok {
+		return constant.ReceiveMessage, nil
+	}
+	return opt, nil
+}
+
+// 设置为正常接收时删除记录
+func (s *fileStore) SetRecvOpt(userID, conversationID string, opt int32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	opts, err := s.get(userID)
+	if err != nil {
+		return err
+	}
+	next := make(map[string]int32, len(opts)+1)
+	for k, v := range opts {
+		next[k] = v
+	}
+	if opt == constant.ReceiveMessage {
+		delete(next, conversationID)
+	} else {
+		next[conversationID] = opt
+	}
+	if err := s.save(userID, next); err != nil {
+		return err
+	}
+	s.opts[userID] = next
+	return nil
+}
+
+func (s *fileStore) GetAllRecvOpts(userID string) (map[string]int32, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	opts, err := s.get(userID)
+	if err != nil {
+		return nil, err
+	}
+	copied := make(map[string]int32, len(opts))
+	for k, v := range opts {
+		copied[k] = v
+	}
+	return copied, nil
+}
@@ -0,0 +1,255 @@
+package friend
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 一个用户在文件中的内容
+type userFile struct {
+	Friends       map[string]*Friend      //好友id -> 好友
+	Blacks        map[string]*Black       //被拉黑用户id -> 黑名单
+	RequireFriend bool                    //只接收好友消息
+	Applications  map[string]*Application //申请者id -> 收到的好友申请
+}
+
+func newUserFile() *userFile {
+	return &userFile{
+		Friends:      make(map[string]*Friend),
+		Blacks:       make(map[string]*Black),
+		Applications: make(map[string]*Application),
+	}
+}
+
+// 修改前复制一份，写文件成功后才替换缓存
+func (u *userFile) clone() *userFile {
+	next := newUserFile()
+	for k, v := range u.Friends {
+		next.Friends[k] = v
+	}
+	for k, v := range u.Blacks {
+		next.Blacks[k] = v
+	}
+	for k, v := range u.Applications {
+		next.Applications[k] = v
+	}
+	next.RequireFriend = u.RequireFriend
+	return next
+}
+
+// 本地文件好友存储，每个用户一个文件，内容为该用户的好友、黑名单、收到的好友申请的json
+// 内存中缓存已读取的用户，每次修改都会写回文件
+type fileStore struct {
+	dir   string
+	mutex sync.Mutex
+	users map[string]*userFile
+}
+
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, users: make(map[string]*userFile)}, nil
+}
+
+// 用户id做hex编码避免出现路径分隔符
+func (s *fileStore) file(userID string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(userID)))
+}
+
+func (s *fileStore) get(userID string) (*userFile, error) {
+	if u, ok := s.users[userID]; ok {
+		return u, nil
+	}
+	u := newUserFile()
+	data, err := os.ReadFile(s.file(userID))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, u); err != nil {
+			return nil, fmt.Errorf("parse friend file of %s: %w", userID, err)
+		}
+	}
+	s.users[userID] = u.clone()
+	return s.users[userID], nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (s *fileStore) save(userID string, u *userFile) error {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	name := s.file(userID)
+	if err := os.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(name+".tmp", name); err != nil {
+		return err
+	}
+	s.users[userID] = u
+	return nil
+}
+
+// 读取用户后修改并写回
+func (s *fileStore) update(userID string, fn func(u *userFile) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	u, err := s.get(userID)
+	if err != nil {
+		return err
+	}
+	next := u.clone()
+	if err := fn(next); err != nil {
+		return err
+	}
+	return s.save(userID, next)
+}
+
+// 读取用户，fn中不能修改用户
+func (s *fileStore) view(userID string, fn func(u *userFile)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	u, err := s.get(userID)
+	if err != nil {
+		return err
+	}
+	fn(u)
+	return nil
+}
+
+func (s *fileStore) IsFriend(ownerUserID, friendUserID string) (ok bool, err error) {
+	err = s.view(ownerUserID, func(u *userFile) { _, ok = u.Friends[friendUserID] })
+	return ok, err
+}
+
+func (s *fileStore) IsBlack(ownerUserID, blockUserID string) (ok bool, err error) {
+	err = s.view(ownerUserID, func(u *userFile) { _, ok = u.Blacks[blockUserID] })
+	return ok, err
+}
+
+func (s *fileStore) RequireFriend(userID string) (require bool, err error) {
+	err = s.view(userID, func(u *userFile) { require = u.RequireFriend })
+	return require, err
+}
+
+func (s *fileStore) GetFriend(ownerUserID, friendUserID string) (*Friend, error) {
+	var friend *Friend
+	err := s.view(ownerUserID, func(u *userFile) {
+		if f, ok := u.Friends[friendUserID]; ok {
+			copied := *f
+			friend = &copied
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if friend == nil {
+		return nil, ErrFriendNotExist
+	}
+	return friend, nil
+}
+
+func (s *fileStore) GetFriends(ownerUserID string) (friends []*Friend, err error) {
+	err = s.view(ownerUserID, func(u *userFile) {
+		friends = make([]*Friend, 0, len(u.Friends))
+		for _, f := range u.Friends {
+			friend := *f
+			friends = append(friends, &friend)
+		}
+	})
+	return friends, err
+}
+
+// 已是好友时覆盖原记录
+func (s *fileStore) AddFriend(f *Friend) error {
+	return s.update(f.OwnerUserID, func(u *userFile) error {
+		friend := *f
+		u.Friends[f.FriendUserID] = &friend
+		return nil
+	})
+}
+
+func (s *fileStore) DeleteFriend(ownerUserID, friendUserID string) error {
+	return s.update(ownerUserID, func(u *userFile) error {
+		if _, ok := u.Friends[friendUserID]; !ok {
+			return ErrFriendNotExist
+		}
+		delete(u.Friends, friendUserID)
+		return nil
+	})
+}
+
+func (s *fileStore) AddBlack(b *Black) error {
+	return s.update(b.OwnerUserID, func(u *userFile) error {
+		black := *b
+		u.Blacks[b.BlockUserID] = &black
+		return nil
+	})
+}
+
+func (s *fileStore) RemoveBlack(ownerUserID, blockUserID string) error {
+	return s.update(ownerUserID, func(u *userFile) error {
+		delete(u.Blacks, blockUserID)
+		return nil
+	})
+}
+
+func (s *fileStore) GetBlackList(ownerUserID string) (blacks []*Black, err error) {
+	err = s.view(ownerUserID, func(u *userFile) {
+		blacks = make([]*Black, 0, len(u.Blacks))
+		for _, b := range u.Blacks {
+			black := *b
+			blacks = append(blacks, &black)
+		}
+	})
+	return blacks, err
+}
+
+func (s *fileStore) SetRequireFriend(userID string, require bool) error {
+	return s.update(userID, func(u *userFile) error {
+		u.RequireFriend = require
+		return nil
+	})
+}
+
+func (s *fileStore) GetApplication(fromUserID, toUserID string) (*Application, error) {
+	var apply *Application
+	err := s.view(toUserID, func(u *userFile) {
+		if a, ok := u.Applications[fromUserID]; ok {
+			copied := *a
+			apply = &copied
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if apply == nil {
+		return nil, ErrApplicationNotExist
+	}
+	return apply, nil
+}
+
+func (s *fileStore) GetApplications(toUserID string) (applies []*Application, err error) {
+	err = s.view(toUserID, func(u *userFile) {
+		applies = make([]*Application, 0, len(u.Applications))
+		for _, a := range u.Applications {
+			apply := *a
+			applies = append(applies, &apply)
+		}
+	})
+	return applies, err
+}
+
+func (s *fileStore) SaveApplication(a *Application) error {
+	return s.update(a.ToUserID, func(u *userFile) error {
+		apply := *a
+		u.Applications[a.FromUserID] = &apply
+		return nil
+	})
+}
@@ -0,0 +1,257 @@
+package friend
+
+import (
+	"errors"
+	"fmt"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"sync"
+)
+
+const (
+	//好友存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+var (
+	ErrFriendNotExist      = errors.New("friend not exist")
+	ErrApplicationNotExist = errors.New("friend application not exist")
+)
+
+type Friend struct {
+	OwnerUserID  string
+	FriendUserID string
+	Remark       string
+	CreateTime   int64
+}
+
+type Black struct {
+	OwnerUserID string
+	BlockUserID string
+	CreateTime  int64
+}
+
+// 好友申请，同一对用户只保留最近一次申请
+type Application struct {
+	FromUserID   string
+	ToUserID     string
+	ReqMsg       string
+	HandleResult int32 //constant.ApplicationFriendFlag/FriendFlag/RefuseFriendFlag
+	HandleMsg    string
+	CreateTime   int64
+	HandleTime   int64
+}
+
+// 用户关系查询，消息服务投递单聊消息时使用
+type Relation interface {
+	//ownerUserID的好友列表中是否有friendUserID
+	IsFriend(ownerUserID, friendUserID string) (bool, error)
+	//ownerUserID是否拉黑了blockUserID
+	IsBlack(ownerUserID, blockUserID string) (bool, error)
+	//是否只接收好友的消息
+	RequireFriend(userID string) (bool, error)
+}
+
+// 好友及黑名单存储
+// 好友关系是单向记录的，互为好友需要双方各存一条
+type Store interface {
+	Relation
+	GetFriend(ownerUserID, friendUserID string) (*Friend, error)
+	GetFriends(ownerUserID string) ([]*Friend, error)
+	AddFriend(f *Friend) error
+	DeleteFriend(ownerUserID, friendUserID string) error
+	AddBlack(b *Black) error
+	RemoveBlack(ownerUserID, blockUserID string) error
+	GetBlackList(ownerUserID string) ([]*Black, error)
+	SetRequireFriend(userID string, require bool) error
+	GetApplication(fromUserID, toUserID string) (*Application, error)
+	//toUserID收到的好友申请
+	GetApplications(toUserID string) ([]*Application, error)
+	//已存在时覆盖
+	SaveApplication(a *Application) error
+}
+
+func NewStore(cfg config.Friend) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return NewFileStore(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported friend backend: %s", cfg.Backend)
+}
+
+// 内存好友存储，重启后数据会丢失，只适合测试使用
+type memoryStore struct {
+	rwLock  *sync.RWMutex
+	friends map[string]map[string]*Friend      //用户id -> 好友id -> 好友
+	blacks  map[string]map[string]*Black       //用户id -> 被拉黑用户id -> 黑名单
+	require map[string]bool                    //只接收好友消息的用户
+	applies map[string]map[string]*Application //接收者id -> 申请者id -> 好友申请
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		rwLock:  new(sync.RWMutex),
+		friends: make(map[string]map[string]*Friend),
+		blacks:  make(map[string]map[string]*Black),
+		require: make(map[string]bool),
+		applies: make(map[string]map[string]*Application),
+	}
+}
+
+// 好友关系标记，constant.FriendFlag为好友，constant.ApplicationFriendFlag为需要申请好友
+func FriendFlag(r Relation, ownerUserID, friendUserID string) (int32, error) {
+	ok, err := r.IsFriend(ownerUserID, friendUserID)
+	if err != nil || !ok {
+		return constant.ApplicationFriendFlag, err
+	}
+	return constant.FriendFlag, nil
+}
+
+// 黑名单标记，constant.BlackListFlag为已拉黑
+func BlackFlag(r Relation, ownerUserID, blockUserID string) (int32, error) {
+	ok, err := r.IsBlack(ownerUserID, blockUserID)
+	if err != nil || !ok {
+		return 0, err
+	}
+	return constant.BlackListFlag, nil
+}
+
+func (s *memoryStore) IsFriend(ownerUserID, friendUserID string) (bool, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	_, ok := s.friends[ownerUserID][friendUserID]
+	return ok, nil
+}
+
+func (s *memoryStore) IsBlack(ownerUserID, blockUserID string) (bool, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	_, ok := s.blacks[ownerUserID][blockUserID]
+	return ok, nil
+}
+
+func (s *memoryStore) RequireFriend(userID string) (bool, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	return s.require[userID], nil
+}
+
+func (s *memoryStore) GetFriend(ownerUserID, friendUserID string) (*Friend, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	f, ok := s.friends[ownerUserID][friendUserID]
+	if !ok {
+		return nil, ErrFriendNotExist
+	}
+	friend := *f
+	return &friend, nil
+}
+
+func (s *memoryStore) GetFriends(ownerUserID string) ([]*Friend, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	friends := make([]*Friend, 0, len(s.friends[ownerUserID]))
+	for _, f := range s.friends[ownerUserID] {
+		friend := *f
+		friends = append(friends, &friend)
+	}
+	return friends, nil
+}
+
+// 已是好友时覆盖原记录
+func (s *memoryStore) AddFriend(f *Friend) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.friends[f.OwnerUserID]; !ok {
+		s.friends[f.OwnerUserID] = make(map[string]*Friend)
+	}
+	friend := *f
+	s.friends[f.OwnerUserID][f.FriendUserID] = &friend
+	return nil
+}
+
+func (s *memoryStore) DeleteFriend(ownerUserID, friendUserID string) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.friends[ownerUserID][friendUserID]; !ok {
+		return ErrFriendNotExist
+	}
+	delete(s.friends[ownerUserID], friendUserID)
+	return nil
+}
+
+func (s *memoryStore) AddBlack(b *Black) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.blacks[b.OwnerUserID]; !ok {
+		s.blacks[b.OwnerUserID] = make(map[string]*Black)
+	}
+	black := *b
+	s.blacks[b.OwnerUserID][b.BlockUserID] = &black
+	return nil
+}
+
+func (s *memoryStore) RemoveBlack(ownerUserID, blockUserID string) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	delete(s.blacks[ownerUserID], blockUserID)
+	return nil
+}
+
+func (s *memoryStore) GetBlackList(ownerUserID string) ([]*Black, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	blacks := make([]*Black, 0, len(s.blacks[ownerUserID]))
+	for _, b := range s.blacks[ownerUserID] {
+		black := *b
+		blacks = append(blacks, &black)
+	}
+	return blacks, nil
+}
+
+func (s *memoryStore) SetRequireFriend(userID string, require bool) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if require {
+		s.require[userID] = true
+	} else {
+		delete(s.require, userID)
+	}
+	return nil
+}
+
+func (s *memoryStore) GetApplication(fromUserID, toUserID string) (*Application, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	a, ok := s.applies[toUserID][fromUserID]
+	if !ok {
+		return nil, ErrApplicationNotExist
+	}
+	apply := *a
+	return &apply, nil
+}
+
+func (s *memoryStore) GetApplications(toUserID string) ([]*Application, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	applies := make([]*Application, 0, len(s.applies[toUserID]))
+	for _, a := range s.applies[toUserID] {
+		apply := *a
+		applies = append(applies, &apply)
+	}
+	return applies, nil
+}
+
+func (s *memoryStore) SaveApplication(a *Application) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.applies[a.ToUserID]; !ok {
+		s.applies[a.ToUserID] = make(map[string]*Application)
+	}
+	apply := *a
+	s.applies[a.ToUserID][a.FromUserID] = &apply
+	return nil
+}
@@ -0,0 +1,220 @@
+package group
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 一个群在文件中的内容
+type groupFile struct {
+	Group   *Group
+	Members map[string]*Member //用户id -> 成员
+}
+
+// 本地文件群存储，每个群一个文件，内容为群信息和所有成员的json
+// 内存中缓存已读取的群，每次修改都会写回文件
+type fileStore struct {
+	dir    string
+	mutex  sync.Mutex
+	groups map[string]*groupFile //不存在的群缓存为nil
+}
+
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, groups: make(map[string]*groupFile)}, nil
+}
+
+// 群id做hex编码避免出现路径分隔符
+func (s *fileStore) file(groupID string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(groupID)))
+}
+
+// 群不存在时返回nil
+func (s *fileStore) get(groupID string) (*groupFile, error) {
+	if g, ok := s.groups[groupID]; ok {
+		return g, nil
+	}
+	data, err := os.ReadFile(s.file(groupID))
+	if os.IsNotExist(err) {
+		s.groups[groupID] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	g := groupFile{}
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("parse group file of %s: %w", groupID, err)
+	}
+	if g.Members == nil {
+		g.Members = make(map[string]*Member)
+	}
+	s.groups[groupID] = &g
+	return &g, nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (s *fileStore) save(groupID string, g *groupFile) error {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	name := s.file(groupID)
+	if err := os.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(name+".tmp", name); err != nil {
+		return err
+	}
+	s.groups[groupID] = g
+	return nil
+}
+
+// 修改前复制一份，写文件成功后才替换缓存
+func (g *groupFile) clone() *groupFile {
+	group := *g.Group
+	next := groupFile{Group: &group, Members: make(map[string]*Member, len(g.Members))}
+	for userID, m := range g.Members {
+		next.Members[userID] = m
+	}
+	return &next
+}
+
+func (s *fileStore) GetGroup(groupID string) (*Group, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	g, err := s.get(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, ErrGroupNotExist
+	}
+	group := *g.Group
+	return &group, nil
+}
+
+func (s *fileStore) GetMember(groupID, userID string) (*Member, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	g, err := s.get(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || g.Members[userID] == nil {
+		return nil, ErrMemberNotExist
+	}
+	member := *g.Members[userID]
+	return &member, nil
+}
+
+func (s *fileStore) GetMemberIDs(groupID string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	g, err := s.get(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, ErrGroupNotExist
+	}
+	userIDs := make([]string, 0, len(g.Members))
+	for userID := range g.Members {
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+func (s *fileStore) CreateGroup(g *Group, members []*Member) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	old, err := s.get(g.GroupID)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		return ErrGroupExist
+	}
+	group := *g
+	next := groupFile{Group: &group, Members: make(map[string]*Member, len(members))}
+	for _, m := range members {
+		member := *m
+		next.Members[m.UserID] = &member
+	}
+	return s.save(g.GroupID, &next)
+}
+
+func (s *fileStore) UpdateGroup(g *Group) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	old, err := s.get(g.GroupID)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		return ErrGroupNotExist
+	}
+	next := old.clone()
+	*next.Group = *g
+	return s.save(g.GroupID, next)
+}
+
+func (s *fileStore) AddMembers(groupID string, members []*Member) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	old, err := s.get(groupID)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		return ErrGroupNotExist
+	}
+	next := old.clone()
+	for _, m := range members {
+		if _, ok := next.Members[m.UserID]; ok {
+			continue
+		}
+		member := *m
+		next.Members[m.UserID] = &member
+	}
+	return s.save(groupID, next)
+}
+
+func (s *fileStore) RemoveMembers(groupID string, userIDs []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	old, err := s.get(groupID)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		return ErrGroupNotExist
+	}
+	next := old.clone()
+	for _, userID := range userIDs {
+		delete(next.Members, userID)
+	}
+	return s.save(groupID, next)
+}
+
+func (s *fileStore) UpdateMember(m *Member) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	old, err := s.get(m.GroupID)
+	if err != nil {
+		return err
+	}
+	if old == nil || old.Members[m.UserID] == nil {
+		return ErrMemberNotExist
+	}
+	next := old.clone()
+	member := *m
+	next.Members[m.UserID] = &member
+	return s.save(m.GroupID, next)
+}
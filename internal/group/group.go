@@ -0,0 +1,180 @@
+package group
+
+import (
+	"errors"
+	"fmt"
+	"insight/pkg/common/config"
+	"sync"
+)
+
+const (
+	//群存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+var (
+	ErrGroupNotExist  = errors.New("group not exist")
+	ErrGroupExist     = errors.New("group already exist")
+	ErrMemberNotExist = errors.New("member not exist")
+)
+
+type Group struct {
+	GroupID     string
+	GroupName   string
+	OwnerUserID string
+	Status      int32 //constant.GroupOk/GroupBanChat/GroupStatusDismissed/GroupStatusMuted
+	CreateTime  int64
+}
+
+type Member struct {
+	GroupID     string
+	UserID      string
+	RoleLevel   int32 //constant.GroupOrdinaryUsers/GroupAdmin/GroupOwner
+	JoinTime    int64
+	MuteEndTime int64 //禁言结束时间，毫秒时间戳
+}
+
+// 群成员关系查询，消息服务投递群消息时使用
+type Membership interface {
+	GetGroup(groupID string) (*Group, error)
+	GetMember(groupID, userID string) (*Member, error)
+	GetMemberIDs(groupID string) ([]string, error)
+}
+
+// 群存储
+type Store interface {
+	Membership
+	CreateGroup(g *Group, members []*Member) error
+	UpdateGroup(g *Group) error
+	//已是成员的用户会被忽略
+	AddMembers(groupID string, members []*Member) error
+	RemoveMembers(groupID string, userIDs []string) error
+	UpdateMember(m *Member) error
+}
+
+func NewStore(cfg config.Group) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return NewFileStore(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported group backend: %s", cfg.Backend)
+}
+
+// 内存群存储，重启后数据会丢失，只适合测试使用
+type memoryStore struct {
+	rwLock  *sync.RWMutex
+	groups  map[string]*Group
+	members map[string]map[string]*Member //群id -> 用户id -> 成员
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		rwLock:  new(sync.RWMutex),
+		groups:  make(map[string]*Group),
+		members: make(map[string]map[string]*Member),
+	}
+}
+
+func (s *memoryStore) GetGroup(groupID string) (*Group, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	g, ok := s.groups[groupID]
+	if !ok {
+		return nil, ErrGroupNotExist
+	}
+	group := *g
+	return &group, nil
+}
+
+func (s *memoryStore) GetMember(groupID, userID string) (*Member, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	m, ok := s.members[groupID][userID]
+	if !ok {
+		return nil, ErrMemberNotExist
+	}
+	member := *m
+	return &member, nil
+}
+
+func (s *memoryStore) GetMemberIDs(groupID string) ([]string, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	if _, ok := s.groups[groupID]; !ok {
+		return nil, ErrGroupNotExist
+	}
+	userIDs := make([]string, 0, len(s.members[groupID]))
+	for userID := range s.members[groupID] {
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+func (s *memoryStore) CreateGroup(g *Group, members []*Member) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.groups[g.GroupID]; ok {
+		return ErrGroupExist
+	}
+	group := *g
+	s.groups[g.GroupID] = &group
+	s.members[g.GroupID] = make(map[string]*Member)
+	for _, m := range members {
+		member := *m
+		s.members[g.GroupID][m.UserID] = &member
+	}
+	return nil
+}
+
+func (s *memoryStore) UpdateGroup(g *Group) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.groups[g.GroupID]; !ok {
+		return ErrGroupNotExist
+	}
+	group := *g
+	s.groups[g.GroupID] = &group
+	return nil
+}
+
+func (s *memoryStore) AddMembers(groupID string, members []*Member) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.groups[groupID]; !ok {
+		return ErrGroupNotExist
+	}
+	for _, m := range members {
+		if _, ok := s.members[groupID][m.UserID]; ok {
+			continue
+		}
+		member := *m
+		s.members[groupID][m.UserID] = &member
+	}
+	return nil
+}
+
+func (s *memoryStore) RemoveMembers(groupID string, userIDs []string) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.groups[groupID]; !ok {
+		return ErrGroupNotExist
+	}
+	for _, userID := range userIDs {
+		delete(s.members[groupID], userID)
+	}
+	return nil
+}
+
+func (s *memoryStore) UpdateMember(m *Member) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.members[m.GroupID][m.UserID]; !ok {
+		return ErrMemberNotExist
+	}
+	member := *m
+	s.members[m.GroupID][m.UserID] = &member
+	return nil
+}
@@ -0,0 +1,94 @@
+package kick
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 本地文件踢下线记录存储，每个用户一个文件，内容为 platformID -> 记录 的json
+// 内存中缓存已读取的记录，每次修改都会写回文件
+type fileStore struct {
+	dir     string
+	mutex   sync.Mutex
+	records map[string]map[int32]Record
+}
+
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, records: make(map[string]map[int32]Record)}, nil
+}
+
+// userID做hex编码避免出现路径分隔符
+func (s *fileStore) file(userID string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(userID)))
+}
+
+func (s *fileStore) get(userID string) (map[int32]Record, error) {
+	if records, ok := s.records[userID]; ok {
+		return records, nil
+	}
+	records := make(map[int32]Record)
+	data, err := os.ReadFile(s.file(userID))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("parse kick file of %s: %w", userID, err)
+		}
+	}
+	s.records[userID] = records
+	return records, nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (s *fileStore) save(userID string, records map[int32]Record) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	name := s.file(userID)
+	if err := os.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (s *fileStore) Set(userID string, platformID int32, r Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	records, err := s.get(userID)
+	if err != nil {
+		return err
+	}
+	next := make(map[int32]Record, len(records)+1)
+	for k, v := range records {
+		next[k] = v
+	}
+	next[platformID] = r
+	if err := s.save(userID, next); err != nil {
+		return err
+	}
+	s.records[userID] = next
+	return nil
+}
+
+func (s *fileStore) Get(userID string) (map[int32]Record, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	records, err := s.get(userID)
+	if err != nil {
+		return nil, err
+	}
+	copied := make(map[int32]Record, len(records))
+	for k, v := range records {
+		copied[k] = v
+	}
+	return copied, nil
+}
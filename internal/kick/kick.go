@@ -0,0 +1,52 @@
+package kick
+
+import (
+	"fmt"
+	"insight/pkg/common/config"
+)
+
+const (
+	//踢下线记录存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// 吊销用户所有平台时使用的platformID
+const AllPlatform int32 = 0
+
+// 用户在一个平台上最近一次踢下线的记录
+// 签发时间不晚于Time的token都视为已踢下线，Except除外(踢人时新登录的token)
+// token签发时间精确到秒，与踢人同一秒签发的其他token也会被踢下线，需要重新登录
+type Record struct {
+	Time   int64  `json:"time"` //秒
+	Except string `json:"except"`
+}
+
+// 踢下线记录存储
+// token本身只校验签名和有效期，服务重启后已签发的token仍然有效，只需要持久化踢下线记录
+type Store interface {
+	//覆盖用户在platformID上的记录
+	Set(userID string, platformID int32, r Record) error
+	//用户所有平台的记录，没有记录时为空
+	Get(userID string) (map[int32]Record, error)
+}
+
+func NewStore(cfg config.Kick) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return NewFileStore(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported kick backend: %s", cfg.Backend)
+}
+
+// token是否已被踢下线，platformID上和所有平台上的记录都要检查
+func Kicked(records map[int32]Record, platformID int32, issuedAt int64, tokenString string) bool {
+	for _, id := range []int32{platformID, AllPlatform} {
+		if r, ok := records[id]; ok && issuedAt <= r.Time && tokenString != r.Except {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package kick
+
+import "sync"
+
+// 内存踢下线记录存储，重启后被踢的token会恢复有效，只适合测试使用
+type memoryStore struct {
+	rwLock  *sync.RWMutex
+	records map[string]map[int32]Record //userID -> platformID -> 记录
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		rwLock:  new(sync.RWMutex),
+		records: make(map[string]map[int32]Record),
+	}
+}
+
+func (s *memoryStore) Set(userID string, platformID int32, r Record) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	records, ok := s.records[userID]
+	if !ok {
+		records = make(map[int32]Record)
+		s.records[userID] = records
+	}
+	records[platformID] = r
+	return nil
+}
+
+func (s *memoryStore) Get(userID string) (map[int32]Record, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	records := make(map[int32]Record, len(s.records[userID]))
+	for k, v := range s.records[userID] {
+		records[k] = v
+	}
+	return records, nil
+}
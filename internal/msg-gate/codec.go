@@ -0,0 +1,291 @@
+package msggate
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"insight/internal/content"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	//协议版本号，protobuf帧的第一个字节
+	ProtocolVersion byte = 1
+
+	//编码方式，客户端在登录参数codec或ws子协议(Sec-WebSocket-Protocol)中指定，默认protobuf
+	CodecProtobuf = "protobuf"
+	CodecJson     = "json" //web调试工具使用，ws下使用文本消息
+	CodecGob      = "gob"  //兼容老的go客户端
+)
+
+// 支持的编码方式，同时也是ws支持的子协议，按优先级排列
+var codecNames = []string{CodecProtobuf, CodecJson, CodecGob}
+
+var errUnsupportedVersion = errors.New("unsupported protocol version")
+
+// 上下行消息的编解码
+type Codec interface {
+	Name() string
+	Decode(data []byte, req *Req) error
+	Encode(resp *Resp) ([]byte, error)
+}
+
+// 根据登录参数选择编码方式
+func codecByName(name string) (Codec, error) {
+	switch name {
+	case "", CodecProtobuf:
+		return protobufCodec{}, nil
+	case CodecJson:
+		return jsonCodec{}, nil
+	case CodecGob:
+		return gobCodec{}, nil
+	}
+	return nil, fmt.Errorf("unsupported codec: %s", name)
+}
+
+// 带版本号帧头的protobuf编码
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string {
+	return CodecProtobuf
+}
+
+func (protobufCodec) Decode(data []byte, req *Req) error {
+	if len(data) == 0 || data[0] != ProtocolVersion {
+		return errUnsupportedVersion
+	}
+	pb := rpc.Req{}
+	if err := proto.Unmarshal(data[1:], &pb); err != nil {
+		return err
+	}
+	req.ReqIdentifier = pb.ReqIdentifier
+	req.Token = pb.Token
+	req.SendID = pb.SendID
+	req.OperationID = pb.OperationID
+	req.MsgIncr = pb.MsgIncr
+	req.Data = pb.Data
+	return nil
+}
+
+func (protobufCodec) Encode(resp *Resp) ([]byte, error) {
+	pb := rpc.Resp{
+		ReqIdentifier: resp.ReqIdentifier,
+		MsgIncr:       resp.MsgIncr,
+		OperationID:   resp.OperationID,
+		ErrCode:       resp.ErrCode,
+		ErrMsg:        resp.ErrMsg,
+		Data:          resp.Data,
+	}
+	b, err := proto.Marshal(&pb)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{ProtocolVersion}, b...), nil
+}
+
+// json编码，没有帧头，字段名使用Req/Resp上的json tag
+// data按reqIdentifier对应的protobuf结构转为protojson，消息的content按contentType转为protojson
+// 没有对应结构的data仍为base64
+type jsonCodec struct{}
+
+// json帧，data延迟到知道reqIdentifier后再解析
+type jsonFrame struct {
+	ReqIdentifier int32           `json:"reqIdentifier"`
+	Token         string          `json:"token,omitempty"`
+	SendID        string          `json:"sendID,omitempty"`
+	MsgIncr       string          `json:"msgIncr"`
+	OperationID   string          `json:"operationID"`
+	ErrCode       int32           `json:"errCode"`
+	ErrMsg        string          `json:"errMsg"`
+	Data          json.RawMessage `json:"data,omitempty"`
+}
+
+// 各请求上行data的结构
+var jsonReqData = map[int32]func() proto.Message{
+	constant.WSSendMsg:          func() proto.Message { return &rpc.MsgData{} },
+	constant.WSPullMsgBySeqList: func() proto.Message { return &rpc.PullMessageBySeqListReq{} },
+	constant.WSGetMsgReadStatus: func() proto.Message { return &rpc.GetMsgReadStatusReq{} },
+}
+
+// 各答复和推送下行data的结构
+var jsonRespData = map[int32]func() proto.Message{
+	constant.WSGetNewestSeq:     func() proto.Message { return &rpc.GetMaxAndMinSeqResp{} },
+	constant.WSPullMsgBySeqList: func() proto.Message { return &rpc.PullMessageBySeqListResp{} },
+	constant.WSSendMsg:          func() proto.Message { return &rpc.UserSendMsgResp{} },
+	constant.WSPushMsg:          func() proto.Message { return &rpc.MsgData{} },
+	constant.WSGetMsgReadStatus: func() proto.Message { return &rpc.GetMsgReadStatusResp{} },
+}
+
+func (jsonCodec) Name() string {
+	return CodecJson
+}
+
+func (jsonCodec) Decode(data []byte, req *Req) error {
+	frame := jsonFrame{}
+	if err := json.Unmarshal(data, &frame); err != nil {
+		return err
+	}
+	req.ReqIdentifier = frame.ReqIdentifier
+	req.Token = frame.Token
+	req.SendID = frame.SendID
+	req.OperationID = frame.OperationID
+	req.MsgIncr = frame.MsgIncr
+	if len(frame.Data) == 0 {
+		return nil
+	}
+	newMsg, ok := jsonReqData[frame.ReqIdentifier]
+	//兼容base64字符串形式的data
+	if !ok || frame.Data[0] == '"' {
+		return json.Unmarshal(frame.Data, &req.Data)
+	}
+	m := newMsg()
+	if err := unmarshalJSONData(frame.Data, m); err != nil {
+		return err
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	req.Data = b
+	return nil
+}
+
+func (jsonCodec) Encode(resp *Resp) ([]byte, error) {
+	frame := jsonFrame{
+		ReqIdentifier: resp.ReqIdentifier,
+		MsgIncr:       resp.MsgIncr,
+		OperationID:   resp.OperationID,
+		ErrCode:       resp.ErrCode,
+		ErrMsg:        resp.ErrMsg,
+	}
+	if len(resp.Data) != 0 {
+		var err error
+		if newMsg, ok := jsonRespData[resp.ReqIdentifier]; ok {
+			m := newMsg()
+			if err := proto.Unmarshal(resp.Data, m); err != nil {
+				return nil, err
+			}
+			frame.Data, err = marshalJSONData(m)
+		} else {
+			frame.Data, err = json.Marshal(resp.Data)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(&frame)
+}
+
+// protobuf结构转为json，其中的消息content按contentType展开
+func marshalJSONData(m proto.Message) (json.RawMessage, error) {
+	switch m := m.(type) {
+	case *rpc.MsgData:
+		return marshalJSONMsg(m)
+	case *rpc.PullMessageBySeqListResp:
+		list := make([]json.RawMessage, 0, len(m.List))
+		for _, data := range m.List {
+			b, err := marshalJSONMsg(data)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, b)
+		}
+		return marshalJSONWith(&rpc.PullMessageBySeqListResp{ErrCode: m.ErrCode, ErrMsg: m.ErrMsg}, "list", list)
+	}
+	return protojson.Marshal(m)
+}
+
+// json转为protobuf结构，其中的消息content按contentType解析
+func unmarshalJSONData(b []byte, m proto.Message) error {
+	if data, ok := m.(*rpc.MsgData); ok {
+		return unmarshalJSONMsg(b, data)
+	}
+	return protojson.Unmarshal(b, m)
+}
+
+func marshalJSONMsg(data *rpc.MsgData) (json.RawMessage, error) {
+	c := content.New(data.ContentType)
+	if c == nil || len(data.Content) == 0 {
+		return protojson.Marshal(data)
+	}
+	if err := proto.Unmarshal(data.Content, c); err != nil {
+		return protojson.Marshal(data)
+	}
+	b, err := protojson.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	withoutContent := proto.Clone(data).(*rpc.MsgData)
+	withoutContent.Content = nil
+	return marshalJSONWith(withoutContent, "content", json.RawMessage(b))
+}
+
+func unmarshalJSONMsg(b []byte, data *rpc.MsgData) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	raw, ok := fields["content"]
+	//content为对象时按contentType解析，否则按protojson的base64处理
+	if !ok || len(raw) == 0 || raw[0] != '{' {
+		return protojson.Unmarshal(b, data)
+	}
+	delete(fields, "content")
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(rest, data); err != nil {
+		return err
+	}
+	c := content.New(data.ContentType)
+	if c == nil {
+		return fmt.Errorf("unknown contentType: %d", data.ContentType)
+	}
+	if err := protojson.Unmarshal(raw, c); err != nil {
+		return err
+	}
+	data.Content, err = proto.Marshal(c)
+	return err
+}
+
+// protobuf结构转为json后再加上一个字段
+func marshalJSONWith(m proto.Message, key string, value interface{}) (json.RawMessage, error) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields[key], err = json.Marshal(value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// gob编码，没有帧头
+type gobCodec struct{}
+
+func (gobCodec) Name() string {
+	return CodecGob
+}
+
+func (gobCodec) Decode(data []byte, req *Req) error {
+	return gob.NewDecoder(bytes.NewBuffer(data)).Decode(req)
+}
+
+func (gobCodec) Encode(resp *Resp) ([]byte, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(resp); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -1,8 +1,12 @@
 package msggate
 
 import (
+	"encoding/binary"
+	"errors"
+	"io"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -11,15 +15,104 @@ const (
 	//连接方式
 	ConnModeTcp = 1 //tcp模式
 	ConnModeWs  = 2 //ws模式
+
+	//tcp帧头长度，帧头为大端序的uint32，表示后面消息体的长度
+	tcpFrameHeaderLen = 4
 )
 
+var errFrameTooLarge = errors.New("frame too large")
+
 type Conn struct {
 	connType   int8            // 连接方式
 	tc         *net.TCPConn    //TCP连接
 	ws         *websocket.Conn //Websocket连接
-	wsMutex    sync.Mutex
+	writeMutex sync.Mutex
 	userId     string //用户id
 	token      string
 	PlatformID int    //平台id
 	connID     string //连接id
+	codec      Codec  //上下行消息编码方式，登录时协商
+}
+
+func newWsConn(ws *websocket.Conn, userId string, platformID int, token, connID string, codec Codec) *Conn {
+	return &Conn{
+		connType:   ConnModeWs,
+		ws:         ws,
+		userId:     userId,
+		token:      token,
+		PlatformID: platformID,
+		connID:     connID,
+		codec:      codec,
+	}
+}
+
+func newTcpConn(tc *net.TCPConn, userId string, platformID int, token, connID string, codec Codec) *Conn {
+	return &Conn{
+		connType:   ConnModeTcp,
+		tc:         tc,
+		userId:     userId,
+		token:      token,
+		PlatformID: platformID,
+		connID:     connID,
+		codec:      codec,
+	}
+}
+
+func (c *Conn) RemoteAddr() string {
+	if c.connType == ConnModeTcp {
+		return c.tc.RemoteAddr().String()
+	}
+	return c.ws.RemoteAddr().String()
+}
+
+// 写一帧消息，ws使用二进制消息，tcp使用长度前缀
+func (c *Conn) writeFrame(msg []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	deadline := time.Now().Add(time.Duration(60) * time.Second)
+	if c.connType == ConnModeTcp {
+		c.tc.SetWriteDeadline(deadline)
+		return writeTcpFrame(c.tc, msg)
+	}
+	c.ws.SetWriteDeadline(deadline)
+	msgType := websocket.BinaryMessage
+	if c.codec.Name() == CodecJson {
+		msgType = websocket.TextMessage
+	}
+	return c.ws.WriteMessage(msgType, msg)
+}
+
+// 正常关闭连接，ws会先发送close帧
+func (c *Conn) close(reason string) error {
+	if c.connType == ConnModeTcp {
+		return c.tc.Close()
+	}
+	if reason != "" {
+		c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason), time.Now().Add(time.Second))
+	}
+	return c.ws.Close()
+}
+
+func writeTcpFrame(w io.Writer, msg []byte) error {
+	b := make([]byte, tcpFrameHeaderLen+len(msg))
+	binary.BigEndian.PutUint32(b, uint32(len(msg)))
+	copy(b[tcpFrameHeaderLen:], msg)
+	_, err := w.Write(b)
+	return err
+}
+
+func readTcpFrame(r io.Reader, maxLen int) ([]byte, error) {
+	header := make([]byte, tcpFrameHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(header)
+	if maxLen > 0 && n > uint32(maxLen) {
+		return nil, errFrameTooLarge
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
 }
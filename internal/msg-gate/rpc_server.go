@@ -0,0 +1,88 @@
+package msggate
+
+import (
+	"context"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// 网关rpc服务，后端服务通过它向本网关上的在线连接推送消息
+type GateRpc struct {
+	ws  *WsServer
+	log *zap.Logger
+	rpc.UnimplementedGateServer
+}
+
+func NewGateRpc(ws *WsServer, log *zap.Logger) *GateRpc {
+	return &GateRpc{ws: ws, log: log}
+}
+
+func (g *GateRpc) PushMsgToUser(ctx context.Context, req *rpc.PushMsgToUserReq) (*rpc.PushMsgToUserResp, error) {
+	resp := rpc.PushMsgToUserResp{}
+	data, err := proto.Marshal(req.MsgData)
+	if err != nil {
+		g.log.Error("marshal push msg failed", zap.String("operationID", req.OperationID), zap.String("err", err.Error()))
+		resp.ErrCode = 201
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	for platformID, conn := range g.ws.userConnManager.getUserAllCons(req.UserID) {
+		if len(req.PlatformIDs) != 0 && !lo.Contains(req.PlatformIDs, int32(platformID)) {
+			continue
+		}
+		//发送者同步的消息不再推给发送端连接，发送端已经收到了发送结果
+		if isSenderConn(req.UserID, int32(platformID), req.MsgData) {
+			continue
+		}
+		result := rpc.PushResult{PlatformID: int32(platformID)}
+		err := g.ws.Send(conn, Resp{
+			ReqIdentifier: constant.WSPushMsg,
+			OperationID:   req.OperationID,
+			Data:          data,
+		})
+		if err != nil {
+			result.ResultCode = -1
+		}
+		resp.Results = append(resp.Results, &result)
+	}
+	g.log.Info("push msg to user", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.Int("conns", len(resp.Results)))
+	return &resp, nil
+}
+
+func isSenderConn(userID string, platformID int32, data *rpc.MsgData) bool {
+	return data.MsgFrom == constant.UserMsgType && data.SendID == userID && data.SenderPlatformID == platformID
+}
+
+func (g *GateRpc) KickUser(ctx context.Context, req *rpc.KickUserReq) (*rpc.KickUserResp, error) {
+	resp := rpc.KickUserResp{}
+	kickedConns := g.ws.userConnManager.removeUserConns(req.UserID, req.PlatformIDs)
+	g.ws.kickConns(kickedConns, "kicked by server", req.OperationID)
+	//无论用户是否连在本网关，都吊销其token
+	if err := g.ws.kickToken(req.UserID, req.PlatformIDs, "", req.OperationID); err != nil {
+		resp.ErrCode = 202
+		resp.ErrMsg = err.Error()
+	}
+	g.log.Info("kick user", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.Int32s("platformIDs", req.PlatformIDs), zap.Int("conns", len(kickedConns)))
+	return &resp, nil
+}
+
+func (g *GateRpc) GetUsersOnlineStatus(ctx context.Context, req *rpc.GetUsersOnlineStatusReq) (*rpc.GetUsersOnlineStatusResp, error) {
+	resp := rpc.GetUsersOnlineStatusResp{}
+	for _, userID := range req.UserIDs {
+		status := rpc.UserOnlineStatus{UserID: userID, Status: constant.OfflineStatus}
+		for platformID, conn := range g.ws.userConnManager.getUserAllCons(userID) {
+			status.Status = constant.OnlineStatus
+			status.Platforms = append(status.Platforms, &rpc.PlatformStatus{
+				PlatformID: int32(platformID),
+				Platform:   constant.PlatformIDToName(int32(platformID)),
+				ConnID:     conn.connID,
+			})
+		}
+		resp.Results = append(resp.Results, &status)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,125 @@
+package msggate
+
+import (
+	"encoding/json"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"insight/pkg/common/token"
+	"insight/pkg/utils"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// tcp 长连接服务
+// 每一帧为 4字节大端序长度 + 消息体，连接建立后第一帧是握手帧，
+// 内容为与ws url query相同格式的登录参数(token=xx&userId=xx&platformID=xx&operationID=xx&codec=xx)，
+// 服务端回复一帧json格式的鉴权结果，之后的帧与ws消息体相同
+func NewTcpServer(cfg *config.GateConfig, log *zap.Logger, ws *WsServer) *TcpServer {
+	var t TcpServer
+	t.cfg = cfg
+	t.tcpAddr = ":" + cfg.TcpSvrCfg.Port
+	t.maxMsgLen = cfg.TcpSvrCfg.MaxMsgLen
+	t.log = log
+	t.ws = ws
+	return &t
+}
+
+type TcpServer struct {
+	tcpAddr   string
+	maxMsgLen int
+	cfg       *config.GateConfig
+	log       *zap.Logger
+	ws        *WsServer //复用ws服务的鉴权、消息处理和连接管理
+}
+
+func (t *TcpServer) StartTcp() {
+	addr, err := net.ResolveTCPAddr("tcp", t.tcpAddr)
+	if err != nil {
+		panic("Tcp resolve addr err:" + err.Error())
+	}
+	listener, err := net.ListenTCP("tcp", addr)
+	if err != nil {
+		panic("Tcp listening err:" + err.Error())
+	}
+	t.log.Info("tcp server listen success", zap.String("address", t.tcpAddr))
+
+	for {
+		tc, err := listener.AcceptTCP()
+		if err != nil {
+			t.log.Error("tcp accept error", zap.String("error", err.Error()))
+			continue
+		}
+		go t.handshake(tc)
+	}
+}
+
+// 读取握手帧并鉴权
+func (t *TcpServer) handshake(tc *net.TCPConn) {
+	tc.SetKeepAlive(true)
+	tc.SetReadDeadline(time.Now().Add(time.Duration(t.cfg.TcpSvrCfg.Timeout) * time.Second))
+	frame, err := readTcpFrame(tc, t.maxMsgLen)
+	if err != nil {
+		t.log.Error("tcp read handshake error", zap.String("error", err.Error()), zap.String("userIp", tc.RemoteAddr().String()))
+		tc.Close()
+		return
+	}
+	tc.SetReadDeadline(time.Time{})
+
+	var args *loginArgs
+	query, err := url.ParseQuery(string(frame))
+	if err == nil {
+		args, err = parseLoginArgs(query)
+	}
+	if err != nil {
+		t.log.Error("args err ", zap.String("handshake", string(frame)), zap.String("userIp", tc.RemoteAddr().String()))
+		t.handshakeResp(tc, authResp{ErrCode: constant.InValidToken, ErrMsg: err.Error()})
+		tc.Close()
+		return
+	}
+	if err := t.ws.verifyToken(args); err != nil {
+		t.handshakeResp(tc, authResp{ErrCode: token.State(err), ErrMsg: err.Error()})
+		tc.Close()
+		return
+	}
+	if err := t.handshakeResp(tc, authResp{}); err != nil {
+		tc.Close()
+		return
+	}
+
+	newConn := newTcpConn(
+		tc,
+		args.userId,
+		args.platformID,
+		args.token,
+		tc.RemoteAddr().String()+"_"+strconv.Itoa(int(utils.GetCurrentTimestampByMill())),
+		args.codec,
+	)
+	t.ws.login(newConn, args.operationID)
+	t.readMsg(newConn)
+}
+
+func (t *TcpServer) handshakeResp(tc *net.TCPConn, resp authResp) error {
+	b, _ := json.Marshal(resp)
+	tc.SetWriteDeadline(time.Now().Add(time.Duration(t.cfg.TcpSvrCfg.Timeout) * time.Second))
+	err := writeTcpFrame(tc, b)
+	if err != nil {
+		t.log.Error("tcp write handshake resp error", zap.String("error", err.Error()), zap.String("userIp", tc.RemoteAddr().String()))
+	}
+	return err
+}
+
+func (t *TcpServer) readMsg(conn *Conn) {
+	for {
+		msg, err := readTcpFrame(conn.tc, t.maxMsgLen)
+		if err != nil {
+			t.log.Error("tcp readmsg error", zap.String("error", err.Error()), zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
+			t.ws.userConnManager.delUserConn(conn)
+			return
+		}
+		t.ws.msgParse(conn, msg)
+	}
+}
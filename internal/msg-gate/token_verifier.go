@@ -0,0 +1,36 @@
+package msggate
+
+import (
+	"context"
+	"insight/pkg/common/token"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+	"time"
+)
+
+// 先在本地校验token签名和有效期，再向token服务确认token未被吊销
+type revocableVerifier struct {
+	token.Verifier
+	client rpc.TokenClient
+}
+
+func NewRevocableVerifier(v token.Verifier, client rpc.TokenClient) token.Verifier {
+	return &revocableVerifier{Verifier: v, client: client}
+}
+
+func (v *revocableVerifier) Verify(tokenString string) (*token.Claims, error) {
+	claims, err := v.Verifier.Verify(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	resp, err := v.client.GetTokenState(ctx, &rpc.GetTokenStateReq{Token: tokenString, OperationID: utils.OperationIDGenerator()})
+	if err != nil {
+		return nil, err
+	}
+	if err := token.StateError(resp.State); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
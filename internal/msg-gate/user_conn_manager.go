@@ -5,55 +5,88 @@ import (
 	"insight/pkg/utils"
 	"sync"
 
-	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
 // 管理用户的conn链接
 type UserConnManager struct {
-	rwLock        *sync.RWMutex
-	log           *zap.Logger
-	userConnCount uint64
-	wsConnToUser  map[*Conn]map[int]string //用户id和conn连接的对应关系 支持多端 一对一
-	wsUserToConn  map[string]map[int]*Conn //用户id和conn连接的对应关系 支持多端 一对多
+	rwLock           *sync.RWMutex
+	log              *zap.Logger
+	userConnCount    uint64
+	multiLoginPolicy int                      //多端登录策略 constant.AllLoginButSameTermKick 等
+	wsConnToUser     map[*Conn]map[int]string //用户id和conn连接的对应关系 支持多端 一对一
+	wsUserToConn     map[string]map[int]*Conn //用户id和conn连接的对应关系 支持多端 一对多
 }
 
-func (uc *UserConnManager) onInit(log *zap.Logger) {
+func (uc *UserConnManager) onInit(log *zap.Logger, multiLoginPolicy int) {
 	uc.rwLock = new(sync.RWMutex)
 	uc.log = log
 	uc.userConnCount = 0
+	uc.multiLoginPolicy = multiLoginPolicy
 	uc.wsConnToUser = make(map[*Conn]map[int]string)
 	uc.wsUserToConn = make(map[string]map[int]*Conn)
 }
 
-func (uc *UserConnManager) addUserConn(uid string, platformID int, conn *websocket.Conn, token string, connID, operationID string) (newConn *Conn) {
+// 新连接登录时按多端登录策略找出需要被踢下线的平台
+// 同一个平台只能保留一个连接，新连接总是会踢掉同平台的旧连接
+func kickedPlatforms(policy int, newPlatformID int, oldConnMap map[int]*Conn) (platforms []int) {
+	newClass := constant.PlatformNameToClass(constant.PlatformIDToName(int32(newPlatformID)))
+	for platformID := range oldConnMap {
+		class := constant.PlatformNameToClass(constant.PlatformIDToName(int32(platformID)))
+		kick := platformID == newPlatformID
+		switch policy {
+		case constant.SingleTerminalLogin:
+			kick = true
+		case constant.WebAndOther:
+			//web端可以同时在线，其他端只能有一端在线
+			kick = kick || (newClass != constant.WebPlatformStr && class != constant.WebPlatformStr)
+		case constant.PcMobileAndWeb:
+			//pc端互斥，移动端互斥，web端可以同时在线
+			kick = kick || (newClass != constant.WebPlatformStr && class == newClass)
+		}
+		if kick {
+			platforms = append(platforms, platformID)
+		}
+	}
+	return
+}
+
+// 添加用户连接，返回按多端登录策略被挤下线的旧连接，旧连接已从映射中移除，需由调用方通知并关闭
+func (uc *UserConnManager) addUserConn(newConn *Conn, operationID string) (kickedConns []*Conn) {
 	uc.rwLock.Lock()
 	defer uc.rwLock.Unlock()
 
-	newConn = &Conn{
-		connType:   ConnModeWs,
-		ws:         conn,
-		userId:     uid,
-		token:      token,
-		PlatformID: platformID,
-		connID:     connID,
-	}
+	uid := newConn.userId
+	platformID := newConn.PlatformID
 	uc.log.Info("add user conn",
 		zap.String("func: ", utils.GetSelfFuncName()),
 		zap.String("uid", uid),
-		zap.String("top", token),
-		zap.String("ip", conn.RemoteAddr().String()))
+		zap.String("top", newConn.token),
+		zap.String("ip", newConn.RemoteAddr()))
 
 	//user to conn 的映射map 一对多
 	if oldConnMap, ok := uc.wsUserToConn[uid]; ok {
+		for _, platform := range kickedPlatforms(uc.multiLoginPolicy, platformID, oldConnMap) {
+			oldConn := oldConnMap[platform]
+			delete(oldConnMap, platform)
+			delete(uc.wsConnToUser, oldConn)
+			uc.userConnCount--
+			kickedConns = append(kickedConns, oldConn)
+			uc.log.Info("user kicked by multi terminal login",
+				zap.String("operationID", operationID),
+				zap.String("uid", uid),
+				zap.String("kicked_platform", constant.PlatformIDToName(int32(platform))),
+				zap.String("new_platform", constant.PlatformIDToName(int32(platformID))))
+		}
 		oldConnMap[platformID] = newConn
 		uc.wsUserToConn[uid] = oldConnMap
-		uc.log.Sugar().Info("user not first come in, add conn ", uid, platformID, conn, oldConnMap)
+		uc.log.Sugar().Info("user not first come in, add conn ", uid, platformID, newConn.RemoteAddr(), oldConnMap)
 	} else {
 		i := make(map[int]*Conn)
 		i[platformID] = newConn
 		uc.wsUserToConn[uid] = i
-		uc.log.Sugar().Info("user first come in, add conn ", uid, platformID, conn, oldConnMap)
+		uc.log.Sugar().Info("user first come in, add conn ", uid, platformID, newConn.RemoteAddr(), oldConnMap)
 	}
 
 	//conn to user 的映射map 一对一
@@ -75,32 +108,35 @@ func (uc *UserConnManager) addUserConn(uid string, platformID int, conn *websock
 	return
 }
 
+// 删除用户连接并关闭，已被踢下线的连接不在映射中，由踢人方负责关闭
 func (uc *UserConnManager) delUserConn(conn *Conn) {
 	uc.rwLock.Lock()
 	defer uc.rwLock.Unlock()
-	uc.userConnCount--
 	var uid string
 	var platform int
-	if oldStringMap, okg := uc.wsConnToUser[conn]; okg {
-		for k, v := range oldStringMap {
-			platform = k
-			uid = v
+	oldStringMap, okg := uc.wsConnToUser[conn]
+	if !okg {
+		return
+	}
+	uc.userConnCount--
+	for k, v := range oldStringMap {
+		platform = k
+		uid = v
+	}
+	if oldConnMap, ok := uc.wsUserToConn[uid]; ok {
+		delete(oldConnMap, platform)
+		uc.wsUserToConn[uid] = oldConnMap
+		if len(oldConnMap) == 0 { //用户最后一个链接
+			delete(uc.wsUserToConn, uid)
 		}
-		if oldConnMap, ok := uc.wsUserToConn[uid]; ok {
-			delete(oldConnMap, platform)
-			uc.wsUserToConn[uid] = oldConnMap
-			if len(oldConnMap) == 0 { //用户最后一个链接
-				delete(uc.wsUserToConn, uid)
-			}
-			count := 0
-			for _, v := range uc.wsUserToConn {
-				count = count + len(v)
-			}
-			uc.log.Sugar().Info("WS delete operation", "", "wsUser deleted", uc.wsUserToConn, "disconnection_uid", uid, "disconnection_platform", platform, "online_user_num", len(uc.wsUserToConn), "online_conn_num", count)
+		count := 0
+		for _, v := range uc.wsUserToConn {
+			count = count + len(v)
 		}
-		delete(uc.wsConnToUser, conn)
+		uc.log.Sugar().Info("WS delete operation", "", "wsUser deleted", uc.wsUserToConn, "disconnection_uid", uid, "disconnection_platform", platform, "online_user_num", len(uc.wsUserToConn), "online_conn_num", count)
 	}
-	err := conn.ws.Close()
+	delete(uc.wsConnToUser, conn)
+	err := conn.close("")
 	if err != nil {
 		uc.log.Sugar().Error(" close err", "", "uid", uid, "platform", platform)
 	}
@@ -109,6 +145,29 @@ func (uc *UserConnManager) delUserConn(conn *Conn) {
 	}
 }
 
+// 从连接管理中移除用户指定平台的连接，platformIDs为空时移除所有平台，返回被移除的连接，由调用方负责关闭
+func (uc *UserConnManager) removeUserConns(uid string, platformIDs []int32) (removed []*Conn) {
+	uc.rwLock.Lock()
+	defer uc.rwLock.Unlock()
+	oldConnMap, ok := uc.wsUserToConn[uid]
+	if !ok {
+		return nil
+	}
+	for platform, conn := range oldConnMap {
+		if len(platformIDs) != 0 && !lo.Contains(platformIDs, int32(platform)) {
+			continue
+		}
+		delete(oldConnMap, platform)
+		delete(uc.wsConnToUser, conn)
+		uc.userConnCount--
+		removed = append(removed, conn)
+	}
+	if len(oldConnMap) == 0 {
+		delete(uc.wsUserToConn, uid)
+	}
+	return
+}
+
 func (uc *UserConnManager) getUserConn(uid string, platform int) *Conn {
 	uc.rwLock.RLock()
 	defer uc.rwLock.RUnlock()
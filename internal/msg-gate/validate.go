@@ -1,6 +1,7 @@
 package msggate
 
 import (
+	"insight/internal/content"
 	"insight/pkg/common/constant"
 	"insight/pkg/proto/msg"
 
@@ -8,6 +9,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// 单次按seq拉取消息的最大条数
+const maxPullSeqNum = 100
+
 //websocket 上行，下行结构
 
 type Req struct {
@@ -42,6 +46,37 @@ func (ws *WsServer) argsValidate(req *Req, indetifier int32) (isPass bool, errCo
 			return false, 204, err.Error(), nil
 
 		}
+		//系统通知只能由服务端发出
+		if data.SessionType == constant.NotificationChatType || data.MsgFrom == constant.SysMsgType {
+			ws.log.Error("client can not send notification", zap.String("sendID", data.SendID), zap.Int32("indetifier", indetifier))
+			return false, 204, "client can not send notification", nil
+		}
+		if errCode, errMsg := content.Validate(&data); errCode != 0 {
+			ws.log.Error("content validate err", zap.String("errMsg", errMsg), zap.Int32("contentType", data.ContentType), zap.Int32("indetifier", indetifier))
+			return false, errCode, errMsg, nil
+		}
+		return true, 0, "", &data
+	case constant.WSPullMsgBySeqList:
+		data := msg.PullMessageBySeqListReq{}
+		if err := proto.Unmarshal(req.Data, &data); err != nil {
+			ws.log.Error("unmarshal data struct err", zap.String("errr", err.Error()), zap.Int32("indetifier", indetifier))
+			return false, 203, err.Error(), nil
+		}
+		if len(data.SeqList) == 0 || len(data.SeqList) > maxPullSeqNum {
+			ws.log.Error("seq list len err", zap.Int("len", len(data.SeqList)), zap.Int32("indetifier", indetifier))
+			return false, 204, "seq list len err", nil
+		}
+		return true, 0, "", &data
+	case constant.WSGetMsgReadStatus:
+		data := msg.GetMsgReadStatusReq{}
+		if err := proto.Unmarshal(req.Data, &data); err != nil {
+			ws.log.Error("unmarshal data struct err", zap.String("errr", err.Error()), zap.Int32("indetifier", indetifier))
+			return false, 203, err.Error(), nil
+		}
+		if data.ServerMsgID == "" {
+			ws.log.Error("serverMsgID is empty", zap.Int32("indetifier", indetifier))
+			return false, 204, "serverMsgID is empty", nil
+		}
 		return true, 0, "", &data
 	}
 	return false, 204, "input args err", nil
@@ -1,12 +1,15 @@
 package msggate
 
 import (
-	"bytes"
 	"context"
-	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"insight/pkg/common/config"
+	"insight/pkg/common/token"
 	"insight/pkg/utils"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -16,18 +19,27 @@ import (
 
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
 
-func NewWsServer(cfg *config.GateConfig, log *zap.Logger, validate *validator.Validate) *WsServer {
+func NewWsServer(cfg *config.GateConfig, log *zap.Logger, validate *validator.Validate, verifier token.Verifier) *WsServer {
 	var w WsServer
 	w.cfg = cfg
 	w.wsAddr = ":" + cfg.WsSvrCfg.Port
 	w.wsMaxConnNum = cfg.WsSvrCfg.MaxConnNum
-	w.userConnManager.onInit(log)
+	w.userConnManager.onInit(log, cfg.LoginCfg.MultiTerminalPolicy)
 	w.log = log
+	w.validate = validate
+	w.verifier = verifier
+	//消息服务grpc客户端,后续用服务发现来替换
+	msgConn, err := grpc.Dial(cfg.RpcCfg.MsgAddr, grpc.WithInsecure())
+	if err != nil {
+		panic("dial msg rpc err:" + err.Error())
+	}
+	w.msgConn = msgConn
 	return &w
 }
 
@@ -39,10 +51,17 @@ type WsServer struct {
 	cfg             *config.GateConfig
 	log             *zap.Logger
 	validate        *validator.Validate
+	verifier        token.Verifier
+	msgConn         *grpc.ClientConn
+}
+
+// 鉴权失败时返回给客户端的http body
+type authResp struct {
+	ErrCode int32  `json:"errCode"` //token状态 constant.InValidToken/KickedToken/ExpiredToken
+	ErrMsg  string `json:"errMsg"`
 }
 
 func (w *WsServer) StartWs() {
-	w.validate = w.validate
 	w.upgrader = &websocket.Upgrader{
 		HandshakeTimeout: time.Duration(w.cfg.WsSvrCfg.Timeout) * time.Second,
 		ReadBufferSize:   w.cfg.WsSvrCfg.MaxMsgLen,
@@ -60,49 +79,158 @@ func (w *WsServer) StartWs() {
 
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
-	operationID := ""
-	if len(query["operationID"]) != 0 {
-		operationID = query["operationID"][0]
-	} else {
-		operationID = utils.OperationIDGenerator()
-	}
-
-	if query == nil {
-		ws.log.Error("args is empty")
-		return
-	}
-	if len(query["token"]) == 0 || len(query["userId"]) == 0 && len(query["platformID"]) == 0 {
+	subprotocol := negotiateSubprotocol(query, websocket.Subprotocols(r))
+	args, err := parseLoginArgs(query)
+	if err != nil {
 		ws.log.Error("args err ", zap.Any("query", query))
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	token := query["token"][0]
-	userId := query["userId"][0]
-	platformID := utils.StringToInt(query["platformID"][0])
 
-	if isPass := ws.checkAuth(w, r); isPass {
-		wsConn, err := ws.upgrader.Upgrade(w, r, nil)
+	if isPass := ws.checkAuth(w, args); isPass {
+		var respHeader http.Header
+		if subprotocol != "" {
+			respHeader = http.Header{"Sec-Websocket-Protocol": []string{subprotocol}}
+		}
+		wsConn, err := ws.upgrader.Upgrade(w, r, respHeader)
 		if err != nil {
 			ws.log.Error(err.Error())
 			return
 		}
-		newConn := ws.userConnManager.addUserConn(
-			userId,
-			platformID,
+		newConn := newWsConn(
 			wsConn,
-			token,
+			args.userId,
+			args.platformID,
+			args.token,
 			wsConn.RemoteAddr().String()+"_"+strconv.Itoa(int(utils.GetCurrentTimestampByMill())),
-			operationID,
+			args.codec,
 		)
+		ws.login(newConn, args.operationID)
 		go ws.readMsg(newConn)
 	}
 
 }
 
+// 协商ws子协议，子协议即编码方式
+// url query中指定了codec时以query为准，客户端同时请求了该子协议则回应它，
+// 否则从客户端请求的子协议中选第一个支持的编码写入query
+func negotiateSubprotocol(query url.Values, requested []string) (subprotocol string) {
+	if len(requested) == 0 {
+		return ""
+	}
+	if name := query.Get("codec"); name != "" {
+		if lo.Contains(requested, name) {
+			return name
+		}
+		return ""
+	}
+	for _, name := range requested {
+		if lo.Contains(codecNames, name) {
+			query.Set("codec", name)
+			return name
+		}
+	}
+	return ""
+}
+
+// 登录参数，ws从url query中获取，tcp从握手帧中获取
+type loginArgs struct {
+	token       string
+	userId      string
+	platformID  int
+	operationID string
+	codec       Codec
+}
+
+func parseLoginArgs(query url.Values) (*loginArgs, error) {
+	if len(query["token"]) == 0 || len(query["userId"]) == 0 || len(query["platformID"]) == 0 {
+		return nil, errors.New("args err")
+	}
+	args := loginArgs{
+		token:      query["token"][0],
+		userId:     query["userId"][0],
+		platformID: utils.StringToInt(query["platformID"][0]),
+	}
+	codec, err := codecByName(query.Get("codec"))
+	if err != nil {
+		return nil, err
+	}
+	args.codec = codec
+	if len(query["operationID"]) != 0 {
+		args.operationID = query["operationID"][0]
+	} else {
+		args.operationID = utils.OperationIDGenerator()
+	}
+	return &args, nil
+}
+
+// 鉴权通过的连接加入连接管理，并按多端登录策略踢掉旧连接
+func (ws *WsServer) login(newConn *Conn, operationID string) {
+	kickedConns := ws.userConnManager.addUserConn(newConn, operationID)
+	if len(kickedConns) == 0 {
+		return
+	}
+	ws.kickConns(kickedConns, "kicked by other terminal login", operationID)
+	//吊销被踢连接的token，避免客户端用旧token重连把新登录踢掉
+	platformIDs := make([]int32, 0, len(kickedConns))
+	for _, conn := range kickedConns {
+		platformIDs = append(platformIDs, int32(conn.PlatformID))
+	}
+	ws.kickToken(newConn.userId, platformIDs, newConn.token, operationID)
+}
+
+// 通知被踢下线的连接并关闭，连接需已从连接管理中移除
+func (ws *WsServer) kickConns(kickedConns []*Conn, reason, operationID string) {
+	for _, conn := range kickedConns {
+		ws.Send(conn, Resp{
+			ReqIdentifier: constant.WSKickOnlineMsg,
+			OperationID:   operationID,
+			ErrMsg:        reason,
+		})
+		if err := conn.close("kicked"); err != nil {
+			ws.log.Error("close kicked conn failed", zap.String("error", err.Error()), zap.String("userId", conn.userId), zap.Int("platformID", conn.PlatformID))
+		}
+	}
+}
+
+// 吊销用户指定平台的token，platformIDs为空时吊销所有平台
+func (ws *WsServer) kickToken(userId string, platformIDs []int32, exceptToken, operationID string) error {
+	client := rpc.NewTokenClient(ws.msgConn)
+	_, err := client.KickToken(context.Background(), &rpc.KickTokenReq{
+		UserID:      userId,
+		PlatformIDs: platformIDs,
+		OperationID: operationID,
+		ExceptToken: exceptToken,
+	})
+	if err != nil {
+		ws.log.Error("kick token failed", zap.String("error", err.Error()), zap.String("userId", userId), zap.String("operationID", operationID))
+	}
+	return err
+}
+
 // 用户token鉴权
-func (ws *WsServer) checkAuth(w http.ResponseWriter, r *http.Request) (isPass bool) {
-	//验证token的合法性
-	isPass = true
-	return
+// 校验签名和有效期，并且token必须签发给当前的userId和platformID
+func (ws *WsServer) verifyToken(args *loginArgs) error {
+	claims, err := ws.verifier.Verify(args.token)
+	if err == nil && (claims.UID != args.userId || int(claims.PlatformID) != args.platformID) {
+		err = fmt.Errorf("%w: token not match userId or platformID", token.ErrTokenInvalid)
+	}
+	if err != nil {
+		ws.log.Error("check auth failed", zap.String("userId", args.userId), zap.Int("platformID", args.platformID), zap.String("err", err.Error()))
+	}
+	return err
+}
+
+// ws握手鉴权，失败时返回401
+func (ws *WsServer) checkAuth(w http.ResponseWriter, args *loginArgs) (isPass bool) {
+	if err := ws.verifyToken(args); err != nil {
+		b, _ := json.Marshal(authResp{ErrCode: token.State(err), ErrMsg: err.Error()})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write(b)
+		return false
+	}
+	return true
 }
 
 func (ws *WsServer) readMsg(conn *Conn) {
@@ -112,7 +240,7 @@ func (ws *WsServer) readMsg(conn *Conn) {
 			ws.log.Info("this is a  pong")
 		}
 		if err != nil {
-			ws.log.Error("ws readmsg error", zap.String("error", err.Error()), zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+			ws.log.Error("ws readmsg error", zap.String("error", err.Error()), zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 			ws.userConnManager.delUserConn(conn)
 			return
 		}
@@ -121,12 +249,10 @@ func (ws *WsServer) readMsg(conn *Conn) {
 }
 
 func (ws *WsServer) msgParse(conn *Conn, msg []byte) {
-	b := bytes.NewBuffer(msg)
-	decoder := gob.NewDecoder(b)
 	input := Req{}
-	err := decoder.Decode(&input)
+	err := conn.codec.Decode(msg, &input)
 	if err != nil {
-		ws.log.Error("msg parse error", zap.String("error", err.Error()), zap.String("error", err.Error()), zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+		ws.log.Error("msg parse error", zap.String("error", err.Error()), zap.String("codec", conn.codec.Name()), zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 		return
 	}
 
@@ -137,11 +263,8 @@ func (ws *WsServer) msgParse(conn *Conn, msg []byte) {
 	}
 
 	if input.SendID != conn.userId {
-		if err = conn.ws.Close(); err != nil {
-			ws.log.Error("close ws conn failed", zap.String("error", err.Error()), zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
-			return
-		}
-		ws.log.Error("close ws conn", zap.String("error", err.Error()), zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+		ws.log.Error("sendID not match conn userId, close conn", zap.String("sendID", input.SendID), zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
+		ws.userConnManager.delUserConn(conn)
 		return
 	}
 
@@ -149,11 +272,17 @@ func (ws *WsServer) msgParse(conn *Conn, msg []byte) {
 	case constant.WSSendMsg:
 		//转发消息给msg服务
 		ws.sendMsgReq(conn, &input)
+	case constant.WSGetNewestSeq:
+		ws.getNewestSeqReq(conn, &input)
+	case constant.WSPullMsgBySeqList:
+		ws.pullMsgBySeqListReq(conn, &input)
+	case constant.WSGetMsgReadStatus:
+		ws.getMsgReadStatusReq(conn, &input)
 	case constant.WSHeartbeat:
 		//这里的心跳，赋予新的功能，会用于消息的同步处理
 		ws.heartbeat(conn, &input)
 	default:
-		ws.log.Error("ReqIdentifier failed ", zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+		ws.log.Error("ReqIdentifier failed ", zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 	}
 
 }
@@ -166,7 +295,7 @@ func (ws *WsServer) heartbeat(conn *Conn, msgReq *Req) {
 	// }
 	// msg, err := proto.Marshal(&resp)
 	// if err != nil {
-	// 	ws.log.Error("heartbeat msg error", zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+	// 	ws.log.Error("heartbeat msg error", zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 	// 	return
 	// }
 
@@ -175,32 +304,26 @@ func (ws *WsServer) heartbeat(conn *Conn, msgReq *Req) {
 	// conn.ws.SetWriteDeadline(time.Now().Add(time.Duration(60) * time.Second))
 	// err = conn.ws.WriteMessage(websocket.BinaryMessage, msg)
 	// if err != nil {
-	// 	ws.log.Error("send Heartbeat ws writermsg error", zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+	// 	ws.log.Error("send Heartbeat ws writermsg error", zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 	// }
 }
 
 // 转发消息
 func (ws *WsServer) sendMsgReq(conn *Conn, req *Req) {
 	//to do
-	//ws.log.Info("消息投递", zap.String("userIp", conn.ws.RemoteAddr().String()), zap.String("userId", conn.userId))
+	//ws.log.Info("消息投递", zap.String("userIp", conn.RemoteAddr()), zap.String("userId", conn.userId))
 	nReplay := new(rpc.SendMsgResp)
 	isPass, errCode, errMsg, data := ws.argsValidate(req, constant.WSSendMsg)
 	if isPass {
+		msgData := data.(*rpc.MsgData)
+		//发送端平台以连接为准，推送时用来跳过发送端连接
+		msgData.SenderPlatformID = int32(conn.PlatformID)
 		pdData := rpc.SendMsgReq{
-			Token:       req.Token,
+			Token:       conn.token,
 			OperationID: req.OperationID,
-			Data:        data.(*rpc.MsgData),
+			Data:        msgData,
 		}
-		//消息服务grpc客户端,后续用服务发现来替换
-		clientConn, err := grpc.Dial("127.0.0.1:7749", grpc.WithInsecure())
-		if err != nil {
-			ws.log.Error("msg conn failed")
-			nReplay.ErrCode = 201
-			nReplay.ErrMsg = err.Error()
-			ws.sendMsgResp(conn, req, nReplay)
-			return
-		}
-		client := rpc.NewChatClient(clientConn)
+		client := rpc.NewChatClient(ws.msgConn)
 		resp, err := client.SendMsg(context.Background(), &pdData)
 		if err != nil {
 			ws.log.Error("send msg failed", zap.String("err", errMsg))
@@ -218,6 +341,77 @@ func (ws *WsServer) sendMsgReq(conn *Conn, req *Req) {
 	}
 }
 
+// 获取用户收件箱的最大最小seq
+func (ws *WsServer) getNewestSeqReq(conn *Conn, req *Req) {
+	client := rpc.NewChatClient(ws.msgConn)
+	resp, err := client.GetMaxAndMinSeq(context.Background(), &rpc.GetMaxAndMinSeqReq{
+		UserID:      conn.userId,
+		OperationID: req.OperationID,
+	})
+	if err != nil {
+		ws.log.Error("get max and min seq failed", zap.String("err", err.Error()), zap.String("userId", conn.userId))
+		ws.sendRpcResp(conn, req, 200, err.Error(), nil)
+		return
+	}
+	ws.sendRpcResp(conn, req, resp.ErrCode, resp.ErrMsg, resp)
+}
+
+// 按seq列表拉取用户收件箱的消息，只能拉取自己的收件箱
+func (ws *WsServer) pullMsgBySeqListReq(conn *Conn, req *Req) {
+	isPass, errCode, errMsg, data := ws.argsValidate(req, constant.WSPullMsgBySeqList)
+	if !isPass {
+		ws.sendRpcResp(conn, req, errCode, errMsg, nil)
+		return
+	}
+	pbData := data.(*rpc.PullMessageBySeqListReq)
+	pbData.UserID = conn.userId
+	pbData.OperationID = req.OperationID
+	client := rpc.NewChatClient(ws.msgConn)
+	resp, err := client.PullMessageBySeqList(context.Background(), pbData)
+	if err != nil {
+		ws.log.Error("pull msg by seq list failed", zap.String("err", err.Error()), zap.String("userId", conn.userId))
+		ws.sendRpcResp(conn, req, 200, err.Error(), nil)
+		return
+	}
+	ws.sendRpcResp(conn, req, resp.ErrCode, resp.ErrMsg, resp)
+}
+
+// 查询自己发送的消息的已读状态，查询者以连接的用户为准
+func (ws *WsServer) getMsgReadStatusReq(conn *Conn, req *Req) {
+	isPass, errCode, errMsg, data := ws.argsValidate(req, constant.WSGetMsgReadStatus)
+	if !isPass {
+		ws.sendRpcResp(conn, req, errCode, errMsg, nil)
+		return
+	}
+	pbData := data.(*rpc.GetMsgReadStatusReq)
+	pbData.UserID = conn.userId
+	pbData.OperationID = req.OperationID
+	client := rpc.NewChatClient(ws.msgConn)
+	resp, err := client.GetMsgReadStatus(context.Background(), pbData)
+	if err != nil {
+		ws.log.Error("get msg read status failed", zap.String("err", err.Error()), zap.String("userId", conn.userId))
+		ws.sendRpcResp(conn, req, 200, err.Error(), nil)
+		return
+	}
+	ws.sendRpcResp(conn, req, resp.ErrCode, resp.ErrMsg, resp)
+}
+
+// 把rpc答复序列化后作为data回复给客户端
+func (ws *WsServer) sendRpcResp(conn *Conn, m *Req, errCode int32, errMsg string, pb proto.Message) {
+	var b []byte
+	if pb != nil {
+		b, _ = proto.Marshal(pb)
+	}
+	ws.Send(conn, Resp{
+		ReqIdentifier: m.ReqIdentifier,
+		MsgIncr:       m.MsgIncr,
+		ErrCode:       errCode,
+		ErrMsg:        errMsg,
+		OperationID:   m.OperationID,
+		Data:          b,
+	})
+}
+
 func (ws *WsServer) sendMsgResp(conn *Conn, m *Req, pb *rpc.SendMsgResp) {
 	// := make(map[string]interface{})
 
@@ -239,29 +433,25 @@ func (ws *WsServer) sendMsgResp(conn *Conn, m *Req, pb *rpc.SendMsgResp) {
 }
 
 // 发送答复消息
-func (ws *WsServer) Send(conn *Conn, mReply interface{}) {
-	var b bytes.Buffer
+func (ws *WsServer) Send(conn *Conn, mReply Resp) error {
 	//消息序列化
-	encoder := gob.NewEncoder(&b)
-	err := encoder.Encode(mReply)
+	b, err := conn.codec.Encode(&mReply)
 	if err != nil {
 		uid := conn.userId
 		platform := conn.PlatformID
-		ws.log.Sugar().Error(mReply.(Resp).OperationID, mReply.(Resp).ReqIdentifier, mReply.(Resp).ErrCode, mReply.(Resp).ErrMsg, "Encode Msg error", conn.ws.RemoteAddr().String(), uid, platform, err.Error())
-		return
+		ws.log.Sugar().Error(mReply.OperationID, mReply.ReqIdentifier, mReply.ErrCode, mReply.ErrMsg, "Encode Msg error", conn.RemoteAddr(), uid, platform, err.Error())
+		return err
 	}
-	err = ws.writeMsg(conn, b.Bytes())
+	err = ws.writeMsg(conn, b)
 	if err != nil {
 		uid := conn.userId
 		platform := conn.PlatformID
-		ws.log.Sugar().Error(mReply.(Resp).OperationID, mReply.(Resp).ReqIdentifier, mReply.(Resp).ErrCode, mReply.(Resp).ErrMsg, "WS WriteMsg error", conn.ws.RemoteAddr().String(), uid, platform, err.Error())
+		ws.log.Sugar().Error(mReply.OperationID, mReply.ReqIdentifier, mReply.ErrCode, mReply.ErrMsg, "WS WriteMsg error", conn.RemoteAddr(), uid, platform, err.Error())
 	}
+	return err
 }
 
 // 写到socket里面去
 func (ws *WsServer) writeMsg(conn *Conn, msg []byte) error {
-	conn.wsMutex.Lock()
-	defer conn.wsMutex.Unlock()
-	conn.ws.SetWriteDeadline(time.Now().Add(time.Duration(60) * time.Second))
-	return conn.ws.WriteMessage(websocket.BinaryMessage, msg)
+	return conn.writeFrame(msg)
 }
@@ -0,0 +1,57 @@
+package msg
+
+import (
+	"insight/internal/content"
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+// 解析群聊@消息中被@的成员，@所有人时为除发送者外的所有成员
+// 被@的用户必须是群成员
+func (c *Chat) parseAtUsers(data *msg.MsgData, memberIDs []string) (atUserIDs map[string]bool, errCode int32, errMsg string) {
+	if data.ContentType != constant.AtText {
+		return nil, 0, ""
+	}
+	atText := msg.AtTextContent{}
+	if err := proto.Unmarshal(data.Content, &atText); err != nil {
+		return nil, content.ErrCodeAtText, "unmarshal content err: " + err.Error()
+	}
+	atUserIDs = make(map[string]bool)
+	if lo.Contains(atText.AtUserList, constant.AtAllString) {
+		for _, userID := range memberIDs {
+			atUserIDs[userID] = true
+		}
+	} else {
+		for _, userID := range atText.AtUserList {
+			if !lo.Contains(memberIDs, userID) {
+				return nil, content.ErrCodeAtUserNotInGroup, "at user not in group: " + userID
+			}
+			atUserIDs[userID] = true
+		}
+	}
+	delete(atUserIDs, data.SendID)
+	return atUserIDs, 0, ""
+}
+
+// 被@用户收到的消息拷贝
+// 强制推送和计入未读，content中的isAtSelf标记为true供客户端高亮
+func atMeMsg(req *msg.SendMsgReq) *msg.SendMsgReq {
+	atReq := proto.Clone(req).(*msg.SendMsgReq)
+	if atReq.Data.Options == nil {
+		atReq.Data.Options = make(map[string]bool)
+	}
+	atReq.Data.Options[constant.IsForceNotify] = true
+	atReq.Data.Options[constant.IsOfflinePush] = true
+	atReq.Data.Options[constant.IsUnreadCount] = true
+	content := msg.AtTextContent{}
+	if err := proto.Unmarshal(atReq.Data.Content, &content); err == nil {
+		content.IsAtSelf = true
+		if b, err := proto.Marshal(&content); err == nil {
+			atReq.Data.Content = b
+		}
+	}
+	return atReq
+}
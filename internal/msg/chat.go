@@ -2,64 +2,425 @@ package msg
 
 import (
 	"context"
+	"fmt"
+	"insight/internal/content"
+	"insight/internal/conversation"
+	"insight/internal/friend"
+	"insight/internal/group"
 	"insight/internal/kafka"
+	"insight/internal/receipt"
+	"insight/internal/seq"
+	"insight/internal/storage"
+	"insight/pkg/common/config"
 	"insight/pkg/common/constant"
 	"insight/pkg/proto/msg"
 	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 // 投递消息到mq
 // 用户关系验证
+// 分配seq
 type Chat struct {
 	producer *kafka.Producer
 	log      *zap.Logger
+	token    *Token
+	seq      seq.Allocator
+	store    storage.MessageStore
+	groups   group.Membership
+	friends  friend.Relation
+	receipts receipt.Store
+	convs    conversation.RecvOpts
+	dedup    *dedupCache
+	typing   *typingLimiter
+	//网关grpc客户端，输入状态直接推送
+	gateClients []rpc.GateClient
+	//可撤回的时间窗口，单位秒，0为不限制
+	revokeWindow int64
 	rpc.UnimplementedChatServer
 }
 
-func NewChatServer(log *zap.Logger) *Chat {
+func NewChatServer(cfg *config.MsgConfig, log *zap.Logger, token *Token, groups group.Membership, friends friend.Relation, receipts receipt.Store, convs conversation.RecvOpts) (*Chat, error) {
+	allocator, err := seq.NewAllocator(cfg.SeqCfg)
+	if err != nil {
+		return nil, err
+	}
+	store, err := storage.NewMessageStore(cfg.StorageCfg)
+	if err != nil {
+		return nil, err
+	}
 	chat := Chat{
-		producer: kafka.NewKafkaProducer("127.0.0.1:9092", "ws2ms_chat"),
-		log:      log,
+		producer:     kafka.NewKafkaProducer([]string{"127.0.0.1:9092"}, "ws2ms_chat"),
+		log:          log,
+		token:        token,
+		seq:          allocator,
+		store:        store,
+		groups:       groups,
+		friends:      friends,
+		receipts:     receipts,
+		convs:        convs,
+		dedup:        newDedupCache(),
+		typing:       newTypingLimiter(),
+		revokeWindow: cfg.RevokeCfg.Window,
+	}
+	//网关grpc客户端,后续用服务发现来替换
+	for _, addr := range cfg.RpcCfg.GateAddrs {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			return nil, err
+		}
+		chat.gateClients = append(chat.gateClients, rpc.NewGateClient(conn))
 	}
-	return &chat
+	return &chat, nil
 }
 
 func (c *Chat) SendMsg(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
 
-	//token 验证
+	//token 验证，已被踢下线或过期的token不能再发消息
+	//系统通知由内部服务直接投递，不经过SendMsg
 	resp := msg.SendMsgResp{}
+	if claims, state := c.token.state(req.Token); state != constant.NormalToken || claims.UID != req.Data.SendID {
+		c.log.Error("token invalid", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.Int32("state", state))
+		return returnMsg(&resp, req, 202, "token invalid", "", 0)
+	}
 
+	//SendMsg只接收用户消息，content按消息类型校验，网关已校验过，这里防止绕过网关直接调用
+	//强制提醒只能由服务端设置(被@的拷贝)，客户端设置的忽略，否则可以绕过接收者的免打扰
+	req.Data.MsgFrom = constant.UserMsgType
+	delete(req.Data.Options, constant.IsForceNotify)
+	if errCode, errMsg := content.Validate(req.Data); errCode != 0 {
+		c.log.Error("content validate failed", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.Int32("contentType", req.Data.ContentType), zap.String("errMsg", errMsg))
+		return returnMsg(&resp, req, errCode, errMsg, "", 0)
+	}
+	//输入状态重复发送也没有影响，不需要去重
+	needDedup := req.Data.ContentType != constant.Typing
+	if needDedup && req.Data.ClientMsgID != "" {
+		sent, ok := c.dedup.reserve(req.Data.SendID, req.Data.ClientMsgID)
+		if ok && sent.done {
+			c.log.Info("duplicate msg", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.String("clientMsgID", req.Data.ClientMsgID))
+			return returnMsg(&resp, req, 0, "", sent.serverMsgID, sent.sendTime)
+		}
+		if ok {
+			return returnMsg(&resp, req, 213, "msg is sending", "", 0)
+		}
+	}
+	//消息id和发送时间以服务端为准
+	req.Data.ServerMsgID, req.Data.SendTime = serverMsgID.next()
+	if req.Data.ClientMsgID == "" {
+		req.Data.ClientMsgID = req.Data.ServerMsgID
+	}
+	//send只在还没有任何收件箱投递成功时返回错误，此时释放去重记录允许客户端用同一ClientMsgID重发
+	sendResp, err := c.send(req)
+	if needDedup {
+		if err == nil && sendResp.ErrCode == 0 {
+			c.dedup.done(req.Data.SendID, req.Data.ClientMsgID, sendResp.ServerMsgID, sendResp.SendTime)
+		} else {
+			c.dedup.release(req.Data.SendID, req.Data.ClientMsgID)
+		}
+	}
+	return sendResp, err
+}
+
+// 按会话类型投递消息
+func (c *Chat) send(req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+	resp := msg.SendMsgResp{}
+	fillOptions(req.Data)
+	if req.Data.ContentType == constant.Typing {
+		return c.sendTyping(req)
+	}
+	if req.Data.ContentType == constant.HasReadReceipt {
+		return c.sendReadReceipt(req)
+	}
+	if req.Data.ContentType == constant.Revoke {
+		if errCode, errMsg := c.checkRevoke(req.Data); errCode != 0 {
+			c.log.Error("revoke check failed", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.String("errMsg", errMsg))
+			return returnMsg(&resp, req, errCode, errMsg, "", 0)
+		}
+	}
+	if errCode, errMsg := c.resolveRefs(req.Data); errCode != 0 {
+		c.log.Error("resolve refs failed", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.Int32("contentType", req.Data.ContentType), zap.String("errMsg", errMsg))
+		return returnMsg(&resp, req, errCode, errMsg, "", 0)
+	}
 	switch req.Data.SessionType {
 	case constant.SingleChatType:
+		if errCode, errMsg := c.checkSingleSend(req.Data); errCode != 0 {
+			c.log.Error("single send check failed", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.String("recvId", req.Data.RecvID), zap.String("errMsg", errMsg))
+			return returnMsg(&resp, req, errCode, errMsg, "", 0)
+		}
 		//接收者mq
-		err := c.deliverMsgToKafka(req, req.Data.RecvID)
+		err := c.deliverToRecv(req, req.Data.RecvID)
 		if err != nil {
 			c.log.Error("kfka send msg err", zap.String("recvId", req.Data.RecvID), zap.String("msg", req.String()))
 			return returnMsg(&resp, req, 201, "kfka send msg err", "", 0)
 		}
 		//发送者存mq, 排除自己
+		//接收者已经收到，同步失败时不能让客户端重发，否则接收者会收到重复的消息，改为后台重试
 		if req.Data.SendID != req.Data.RecvID {
-			err = c.deliverMsgToKafka(req, req.Data.SendID)
-			c.log.Error("kfka send msg err", zap.String("sendId", req.Data.SendID), zap.String("msg", req.String()))
-			return returnMsg(&resp, req, 201, "kfka send msg err", "", 0)
+			if err := c.deliverToSender(req); err != nil {
+				c.redeliver(req, []string{req.Data.SendID}, func(string) error { return c.deliverToSender(req) })
+			}
 		}
 		return returnMsg(&resp, req, 0, "", req.Data.ServerMsgID, req.Data.SendTime)
 	case constant.GroupChatType:
+		if errCode, errMsg := c.checkGroupSend(req.Data); errCode != 0 {
+			c.log.Error("group send check failed", zap.String("operationID", req.OperationID), zap.String("groupId", req.Data.GroupID), zap.String("sendId", req.Data.SendID), zap.String("errMsg", errMsg))
+			return returnMsg(&resp, req, errCode, errMsg, "", 0)
+		}
 		//获取群成员
-
-		//遍历群里成员
-		//消息存入kafka收件箱，每一个用户都有一个自己的收件箱，收件箱使用userId来区分
-
+		memberIDs, err := c.groups.GetMemberIDs(req.Data.GroupID)
+		if err != nil {
+			c.log.Error("get group members failed", zap.String("operationID", req.OperationID), zap.String("groupId", req.Data.GroupID), zap.String("err", err.Error()))
+			return returnMsg(&resp, req, 204, err.Error(), "", 0)
+		}
+		atUserIDs, errCode, errMsg := c.parseAtUsers(req.Data, memberIDs)
+		if errCode != 0 {
+			c.log.Error("at users check failed", zap.String("operationID", req.OperationID), zap.String("groupId", req.Data.GroupID), zap.String("sendId", req.Data.SendID), zap.String("errMsg", errMsg))
+			return returnMsg(&resp, req, errCode, errMsg, "", 0)
+		}
+		if err := c.deliverGroupMsg(req, memberIDs, atUserIDs); err != nil {
+			return returnMsg(&resp, req, 201, "kfka send msg err", "", 0)
+		}
+		return returnMsg(&resp, req, 0, "", req.Data.ServerMsgID, req.Data.SendTime)
 	default:
 		//
 	}
 	return returnMsg(&resp, req, 203, "unkonwn sessionType", "", 0)
 }
 
-func (c *Chat) GetMaxAndMinSeq(context.Context, *msg.GetMaxAndMinSeqReq) (*msg.GetMaxAndMinSeqResp, error) {
-	return nil, nil
+// 单聊发送权限检查，被接收者拉黑或接收者只接收好友消息时不能发送
+func (c *Chat) checkSingleSend(data *msg.MsgData) (errCode int32, errMsg string) {
+	if data.SendID == data.RecvID {
+		return 0, ""
+	}
+	blackFlag, err := friend.BlackFlag(c.friends, data.RecvID, data.SendID)
+	if err != nil {
+		return 212, err.Error()
+	}
+	if blackFlag == constant.BlackListFlag {
+		return 210, "in recv blacklist"
+	}
+	require, err := c.friends.RequireFriend(data.RecvID)
+	if err != nil {
+		return 212, err.Error()
+	}
+	if !require {
+		return 0, ""
+	}
+	friendFlag, err := friend.FriendFlag(c.friends, data.RecvID, data.SendID)
+	if err != nil {
+		return 212, err.Error()
+	}
+	if friendFlag != constant.FriendFlag {
+		return 211, "not friend"
+	}
+	return 0, ""
+}
+
+// 群消息发送权限检查
+func (c *Chat) checkGroupSend(data *msg.MsgData) (errCode int32, errMsg string) {
+	g, err := c.groups.GetGroup(data.GroupID)
+	if err != nil {
+		return 204, err.Error()
+	}
+	if g.Status == constant.GroupStatusDismissed {
+		return 205, "group dismissed"
+	}
+	member, err := c.groups.GetMember(data.GroupID, data.SendID)
+	if err != nil {
+		return 206, "not group member"
+	}
+	switch g.Status {
+	case constant.GroupBanChat:
+		//群被封禁，所有人都不能发言
+		return 207, "group banned chat"
+	case constant.GroupStatusMuted:
+		//全员禁言，群主和管理员除外
+		if member.RoleLevel != constant.GroupOwner && member.RoleLevel != constant.GroupAdmin {
+			return 208, "group muted"
+		}
+	}
+	if member.MuteEndTime > utils.GetCurrentTimestampByMill() {
+		return 209, "member muted"
+	}
+	return 0, ""
+}
+
+func (c *Chat) GetMaxAndMinSeq(ctx context.Context, req *msg.GetMaxAndMinSeqReq) (*msg.GetMaxAndMinSeqResp, error) {
+	resp := msg.GetMaxAndMinSeqResp{}
+	maxSeq, err := c.seq.GetMaxSeq(req.UserID)
+	if err != nil {
+		c.log.Error("get max seq failed", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.String("err", err.Error()))
+		resp.ErrCode = 201
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	minSeq, err := c.seq.GetMinSeq(req.UserID)
+	if err != nil {
+		c.log.Error("get min seq failed", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.String("err", err.Error()))
+		resp.ErrCode = 201
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	resp.MaxSeq = maxSeq
+	resp.MinSeq = minSeq
+	return &resp, nil
+}
+
+// 按seq列表从用户收件箱拉取消息，客户端重连后用于补齐缺失的消息
+func (c *Chat) PullMessageBySeqList(ctx context.Context, req *msg.PullMessageBySeqListReq) (*msg.PullMessageBySeqListResp, error) {
+	resp := msg.PullMessageBySeqListResp{}
+	list, err := c.store.GetBySeqList(req.UserID, req.SeqList)
+	if err != nil {
+		c.log.Error("get msg by seq list failed", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.String("err", err.Error()))
+		resp.ErrCode = 201
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	resp.List = list
+	return &resp, nil
+}
+
+// 投递消息到用户收件箱
+// 每个收件箱的seq独立分配，因此每个收件箱投递的是一份带有自己seq的拷贝
+// 投递kafka失败时已分配的seq会空缺，客户端按seq拉取时跳过即可
+// 不需要存储的消息(如输入状态)不分配seq，只推送在线用户
+func (c *Chat) deliverToInbox(req *msg.SendMsgReq, userID string) error {
+	inboxReq := proto.Clone(req).(*msg.SendMsgReq)
+	if storage.NeedStore(req.Data) {
+		s, err := c.seq.Alloc(userID)
+		if err != nil {
+			c.log.Error("alloc seq failed", zap.String("operationID", req.OperationID), zap.String("userID", userID), zap.String("err", err.Error()))
+			return err
+		}
+		inboxReq.Data.Seq = s
+	}
+	return c.deliverMsgToKafka(inboxReq, userID)
+}
+
+// 投递到接收者收件箱，按接收者的会话接收选项处理
+// 不接收的会话直接丢弃，接收但不提醒的会话不做离线推送，被@等强制提醒的消息除外，系统通知不受影响
+func (c *Chat) deliverToRecv(req *msg.SendMsgReq, userID string) error {
+	if req.Data.MsgFrom == constant.SysMsgType {
+		return c.deliverToInbox(req, userID)
+	}
+	conversationID := utils.GetConversationIDByMsg(req.Data, userID)
+	opt, err := c.convs.GetRecvOpt(userID, conversationID)
+	if err != nil {
+		c.log.Error("get recv opt failed", zap.String("operationID", req.OperationID), zap.String("userID", userID), zap.String("conversationID", conversationID), zap.String("err", err.Error()))
+		opt = constant.ReceiveMessage
+	}
+	switch opt {
+	case constant.NotReceiveMessage:
+		return nil
+	case constant.ReceiveNotNotifyMessage:
+		if req.Data.Options[constant.IsForceNotify] {
+			break
+		}
+		recvReq := proto.Clone(req).(*msg.SendMsgReq)
+		if recvReq.Data.Options == nil {
+			recvReq.Data.Options = make(map[string]bool)
+		}
+		recvReq.Data.Options[constant.IsOfflinePush] = false
+		return c.deliverToInbox(recvReq, userID)
+	}
+	return c.deliverToInbox(req, userID)
+}
+
+// 投递到发送者收件箱，同步给发送者的其他端
+// 自己发的消息不计未读，会话是否更新由senderConversationUpdate决定，私密消息不同步
+func (c *Chat) deliverToSender(req *msg.SendMsgReq) error {
+	options := req.Data.Options
+	if !utils.GetSwitchFromOptions(options, constant.IsSenderSync) || !utils.GetSwitchFromOptions(options, constant.IsNotPrivate) {
+		return nil
+	}
+	senderReq := proto.Clone(req).(*msg.SendMsgReq)
+	if senderReq.Data.Options == nil {
+		senderReq.Data.Options = make(map[string]bool)
+	}
+	senderReq.Data.Options[constant.IsUnreadCount] = false
+	senderReq.Data.Options[constant.IsConversationUpdate] = utils.GetSwitchFromOptions(options, constant.IsSenderConversationUpdate)
+	return c.deliverToInbox(senderReq, req.Data.SendID)
+}
+
+// 投递群消息
+// 群时间线只写一份，按群seq存放，然后遍历群里成员投递，被@的成员投递带有提醒标记的拷贝
+// 时间线或任一成员投递成功后消息已对外可见，失败的成员在后台重投，只有全部失败时才返回错误
+func (c *Chat) deliverGroupMsg(req *msg.SendMsgReq, memberIDs []string, atUserIDs map[string]bool) error {
+	delivered := false
+	if storage.NeedStore(req.Data) {
+		if err := c.deliverToInbox(req, seq.GroupKey(req.Data.GroupID)); err != nil {
+			c.log.Error("kfka send msg err", zap.String("groupId", req.Data.GroupID), zap.String("msg", req.String()))
+			return err
+		}
+		delivered = true
+	}
+	//消息存入kafka收件箱，每一个用户都有一个自己的收件箱，收件箱使用userId来区分
+	var atReq *msg.SendMsgReq
+	if len(atUserIDs) != 0 {
+		atReq = atMeMsg(req)
+	}
+	deliver := func(userID string) error {
+		switch {
+		case userID == req.Data.SendID:
+			return c.deliverToSender(req)
+		case atUserIDs[userID]:
+			return c.deliverToRecv(atReq, userID)
+		default:
+			return c.deliverToRecv(req, userID)
+		}
+	}
+	var failed []string
+	for _, userID := range memberIDs {
+		if err := deliver(userID); err != nil {
+			failed = append(failed, userID)
+		} else {
+			delivered = true
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	if !delivered {
+		c.log.Error("kfka send msg err", zap.String("groupId", req.Data.GroupID), zap.Strings("failedIds", failed), zap.String("msg", req.String()))
+		return fmt.Errorf("deliver group msg failed, members: %v", failed)
+	}
+	c.redeliver(req, failed, deliver)
+	return nil
+}
+
+const (
+	//后台重投的次数
+	redeliverTimes = 3
+	//后台重投的首次间隔，之后每次翻倍
+	redeliverInterval = time.Second
+)
+
+// 后台重投失败的收件箱
+// 已有收件箱投递成功时消息已对外可见，不再返回错误让客户端重发，只重投失败的收件箱
+func (c *Chat) redeliver(req *msg.SendMsgReq, userIDs []string, deliver func(userID string) error) {
+	c.log.Error("deliver failed, redeliver later", zap.String("operationID", req.OperationID), zap.String("serverMsgID", req.Data.ServerMsgID), zap.Strings("userIDs", userIDs))
+	go func() {
+		interval := redeliverInterval
+		for i := 0; i < redeliverTimes && len(userIDs) != 0; i++ {
+			time.Sleep(interval)
+			interval *= 2
+			var failed []string
+			for _, userID := range userIDs {
+				if err := deliver(userID); err != nil {
+					failed = append(failed, userID)
+				}
+			}
+			userIDs = failed
+		}
+		if len(userIDs) != 0 {
+			c.log.Error("redeliver failed", zap.String("operationID", req.OperationID), zap.String("serverMsgID", req.Data.ServerMsgID), zap.Strings("userIDs", userIDs))
+		}
+	}()
 }
 
 // 投递消息给kafka
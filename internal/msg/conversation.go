@@ -0,0 +1,91 @@
+package msg
+
+import (
+	"context"
+	"insight/internal/conversation"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// 会话设置
+// 修改后通过ConversationOptChangeNotification同步给用户的其他端
+type Conversation struct {
+	store conversation.Store
+	chat  *Chat
+	log   *zap.Logger
+	rpc.UnimplementedConversationServer
+}
+
+// 参数错误
+const errCodeConversationArgs = 223
+
+func NewConversationServer(log *zap.Logger, store conversation.Store, chat *Chat) *Conversation {
+	return &Conversation{store: store, chat: chat, log: log}
+}
+
+func (c *Conversation) SetReceiveMessageOpt(ctx context.Context, req *rpc.SetReceiveMessageOptReq) (*rpc.SetReceiveMessageOptResp, error) {
+	resp := rpc.SetReceiveMessageOptResp{}
+	if req.OpUserID == "" || len(req.ConversationIDs) == 0 {
+		resp.ErrCode = errCodeConversationArgs
+		resp.ErrMsg = "args err"
+		return &resp, nil
+	}
+	switch req.Opt {
+	case constant.ReceiveMessage, constant.NotReceiveMessage, constant.ReceiveNotNotifyMessage:
+	default:
+		resp.ErrCode = errCodeConversationArgs
+		resp.ErrMsg = "opt err"
+		return &resp, nil
+	}
+	//会话id必须是 utils.GetConversationIDBySessionType 生成的格式，否则投递时匹配不到
+	for _, conversationID := range req.ConversationIDs {
+		if sessionType, _ := utils.ParseConversationID(conversationID); sessionType == 0 {
+			resp.ErrCode = errCodeConversationArgs
+			resp.ErrMsg = "conversationID err: " + conversationID
+			return &resp, nil
+		}
+	}
+	tips := rpc.ConversationOptChangeTips{UserID: req.OpUserID}
+	for _, conversationID := range req.ConversationIDs {
+		if err := c.store.SetRecvOpt(req.OpUserID, conversationID, req.Opt); err != nil {
+			c.log.Error("set recv opt failed", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("conversationID", conversationID), zap.String("err", err.Error()))
+			resp.ErrCode = 206
+			resp.ErrMsg = err.Error()
+			return &resp, nil
+		}
+		tips.List = append(tips.List, &rpc.ConversationOpt{ConversationID: conversationID, Opt: req.Opt})
+	}
+	c.log.Info("set recv opt", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.Strings("conversationIDs", req.ConversationIDs), zap.Int32("opt", req.Opt))
+	tips.OperationTime = utils.GetCurrentTimestampByMill()
+	c.chat.sendNotification(req.OperationID, req.OpUserID, req.OpUserID, constant.ConversationOptChangeNotification, &tips)
+	return &resp, nil
+}
+
+func (c *Conversation) GetReceiveMessageOpt(ctx context.Context, req *rpc.GetReceiveMessageOptReq) (*rpc.GetReceiveMessageOptResp, error) {
+	resp := rpc.GetReceiveMessageOptResp{}
+	if len(req.ConversationIDs) == 0 {
+		opts, err := c.store.GetAllRecvOpts(req.OpUserID)
+		if err != nil {
+			resp.ErrCode = 206
+			resp.ErrMsg = err.Error()
+			return &resp, nil
+		}
+		for conversationID, opt := range opts {
+			resp.List = append(resp.List, &rpc.ConversationOpt{ConversationID: conversationID, Opt: opt})
+		}
+		return &resp, nil
+	}
+	for _, conversationID := range req.ConversationIDs {
+		opt, err := c.store.GetRecvOpt(req.OpUserID, conversationID)
+		if err != nil {
+			resp.ErrCode = 206
+			resp.ErrMsg = err.Error()
+			return &resp, nil
+		}
+		resp.List = append(resp.List, &rpc.ConversationOpt{ConversationID: conversationID, Opt: opt})
+	}
+	return &resp, nil
+}
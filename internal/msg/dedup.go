@@ -0,0 +1,78 @@
+package msg
+
+import (
+	"sync"
+	"time"
+)
+
+// 客户端超时重发的去重窗口
+const dedupWindow = 5 * time.Minute
+
+type sentMsg struct {
+	key         string
+	serverMsgID string
+	sendTime    int64
+	done        bool //false表示第一次发送还在处理中
+	expire      time.Time
+}
+
+// 按(SendID, ClientMsgID)对消息发送去重
+// 记录按写入顺序排列，过期时间也是递增的，每次写入时从队头清理过期记录
+type dedupCache struct {
+	mutex   sync.Mutex
+	entries map[string]*sentMsg
+	queue   []*sentMsg
+}
+
+func newDedupCache() *dedupCache {
+	return &dedupCache{entries: make(map[string]*sentMsg)}
+}
+
+func dedupKey(sendID, clientMsgID string) string {
+	return sendID + ":" + clientMsgID
+}
+
+// 占用一个发送记录，已存在时返回已有记录的拷贝
+func (d *dedupCache) reserve(sendID, clientMsgID string) (sentMsg, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	now := time.Now()
+	d.evict(now)
+	key := dedupKey(sendID, clientMsgID)
+	if e, ok := d.entries[key]; ok {
+		return *e, true
+	}
+	e := &sentMsg{key: key, expire: now.Add(dedupWindow)}
+	d.entries[key] = e
+	d.queue = append(d.queue, e)
+	return sentMsg{}, false
+}
+
+// 发送成功，记录服务端生成的id和时间，重发时原样返回
+func (d *dedupCache) done(sendID, clientMsgID, serverMsgID string, sendTime int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if e, ok := d.entries[dedupKey(sendID, clientMsgID)]; ok {
+		e.serverMsgID = serverMsgID
+		e.sendTime = sendTime
+		e.done = true
+	}
+}
+
+// 发送失败，释放记录允许客户端重发
+func (d *dedupCache) release(sendID, clientMsgID string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.entries, dedupKey(sendID, clientMsgID))
+}
+
+func (d *dedupCache) evict(now time.Time) {
+	i := 0
+	for ; i < len(d.queue) && d.queue[i].expire.Before(now); i++ {
+		//已释放后又重新占用的key不能删除新记录
+		if e, ok := d.entries[d.queue[i].key]; ok && e == d.queue[i] {
+			delete(d.entries, d.queue[i].key)
+		}
+	}
+	d.queue = d.queue[i:]
+}
@@ -0,0 +1,244 @@
+package msg
+
+import (
+	"context"
+	"insight/internal/friend"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// 好友关系及黑名单
+// 关系变更都会通过Chat.sendNotification直接投递对应的通知，保持客户端通讯录实时同步
+type Friend struct {
+	store friend.Store
+	chat  *Chat
+	log   *zap.Logger
+	rpc.UnimplementedFriendServer
+}
+
+func NewFriendServer(log *zap.Logger, store friend.Store, chat *Chat) *Friend {
+	return &Friend{store: store, chat: chat, log: log}
+}
+
+func friendResp(errCode int32, errMsg string) (*rpc.FriendCommonResp, error) {
+	return &rpc.FriendCommonResp{ErrCode: errCode, ErrMsg: errMsg}, nil
+}
+
+// 发送好友通知
+func (f *Friend) notify(operationID, sendID, recvID string, contentType int32, tips *rpc.FriendNotificationTips) {
+	tips.OperationTime = utils.GetCurrentTimestampByMill()
+	f.chat.sendNotification(operationID, sendID, recvID, contentType, tips)
+}
+
+func (f *Friend) AddFriend(ctx context.Context, req *rpc.AddFriendReq) (*rpc.FriendCommonResp, error) {
+	if req.OpUserID == "" || req.ToUserID == "" || req.OpUserID == req.ToUserID {
+		return friendResp(201, "args err")
+	}
+	if ok, err := f.store.IsFriend(req.OpUserID, req.ToUserID); err != nil {
+		return friendResp(206, err.Error())
+	} else if ok {
+		return friendResp(202, "already friend")
+	}
+	if ok, err := f.store.IsBlack(req.ToUserID, req.OpUserID); err != nil {
+		return friendResp(206, err.Error())
+	} else if ok {
+		return friendResp(203, "in black list")
+	}
+	err := f.store.SaveApplication(&friend.Application{
+		FromUserID:   req.OpUserID,
+		ToUserID:     req.ToUserID,
+		ReqMsg:       req.ReqMsg,
+		HandleResult: constant.ApplicationFriendFlag,
+		CreateTime:   utils.GetCurrentTimestampByMill(),
+	})
+	if err != nil {
+		f.log.Error("save friend application failed", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("err", err.Error()))
+		return friendResp(206, err.Error())
+	}
+	f.log.Info("add friend", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("toUserID", req.ToUserID))
+	f.notify(req.OperationID, req.OpUserID, req.ToUserID, constant.FriendApplicationNotification, &rpc.FriendNotificationTips{FromUserID: req.OpUserID, ToUserID: req.ToUserID, ReqMsg: req.ReqMsg})
+	return friendResp(0, "")
+}
+
+func (f *Friend) AddFriendResponse(ctx context.Context, req *rpc.AddFriendResponseReq) (*rpc.FriendCommonResp, error) {
+	if req.HandleResult != constant.FriendFlag && req.HandleResult != constant.RefuseFriendFlag {
+		return friendResp(201, "handleResult err")
+	}
+	apply, err := f.store.GetApplication(req.FromUserID, req.OpUserID)
+	if err != nil || apply.HandleResult != constant.ApplicationFriendFlag {
+		return friendResp(204, "friend application not exist")
+	}
+	now := utils.GetCurrentTimestampByMill()
+	apply.HandleResult = req.HandleResult
+	apply.HandleMsg = req.HandleMsg
+	apply.HandleTime = now
+	if err := f.store.SaveApplication(apply); err != nil {
+		return friendResp(206, err.Error())
+	}
+	tips := rpc.FriendNotificationTips{FromUserID: apply.FromUserID, ToUserID: apply.ToUserID, ReqMsg: apply.ReqMsg, HandleMsg: apply.HandleMsg}
+	if req.HandleResult == constant.RefuseFriendFlag {
+		f.log.Info("refuse friend application", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("fromUserID", req.FromUserID))
+		f.notify(req.OperationID, req.OpUserID, req.FromUserID, constant.FriendApplicationRejectedNotification, &tips)
+		return friendResp(0, "")
+	}
+	//同意后双方互为好友
+	for _, fr := range []*friend.Friend{
+		{OwnerUserID: apply.FromUserID, FriendUserID: apply.ToUserID, CreateTime: now},
+		{OwnerUserID: apply.ToUserID, FriendUserID: apply.FromUserID, CreateTime: now},
+	} {
+		if err := f.store.AddFriend(fr); err != nil {
+			f.log.Error("add friend failed", zap.String("operationID", req.OperationID), zap.String("ownerUserID", fr.OwnerUserID), zap.String("err", err.Error()))
+			return friendResp(206, err.Error())
+		}
+	}
+	f.log.Info("accept friend application", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("fromUserID", req.FromUserID))
+	f.notify(req.OperationID, req.OpUserID, req.FromUserID, constant.FriendApplicationApprovedNotification, &tips)
+	f.notify(req.OperationID, req.OpUserID, req.FromUserID, constant.FriendAddedNotification, &rpc.FriendNotificationTips{FromUserID: apply.FromUserID, ToUserID: apply.ToUserID})
+	return friendResp(0, "")
+}
+
+// 删除好友是单向的，只删除自己好友列表中的记录
+func (f *Friend) DeleteFriend(ctx context.Context, req *rpc.DeleteFriendReq) (*rpc.FriendCommonResp, error) {
+	if err := f.store.DeleteFriend(req.OpUserID, req.FriendUserID); err != nil {
+		return friendResp(205, err.Error())
+	}
+	f.log.Info("delete friend", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("friendUserID", req.FriendUserID))
+	f.notify(req.OperationID, req.OpUserID, req.OpUserID, constant.FriendDeletedNotification, &rpc.FriendNotificationTips{FromUserID: req.OpUserID, ToUserID: req.FriendUserID})
+	return friendResp(0, "")
+}
+
+func (f *Friend) SetFriendRemark(ctx context.Context, req *rpc.SetFriendRemarkReq) (*rpc.FriendCommonResp, error) {
+	fr, err := f.store.GetFriend(req.OpUserID, req.FriendUserID)
+	if err != nil {
+		return friendResp(205, err.Error())
+	}
+	fr.Remark = req.Remark
+	if err := f.store.AddFriend(fr); err != nil {
+		return friendResp(206, err.Error())
+	}
+	f.log.Info("set friend remark", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("friendUserID", req.FriendUserID))
+	f.notify(req.OperationID, req.OpUserID, req.OpUserID, constant.FriendRemarkSetNotification, &rpc.FriendNotificationTips{FromUserID: req.OpUserID, ToUserID: req.FriendUserID, Remark: req.Remark})
+	return friendResp(0, "")
+}
+
+func (f *Friend) GetFriendList(ctx context.Context, req *rpc.GetFriendListReq) (*rpc.GetFriendListResp, error) {
+	resp := rpc.GetFriendListResp{}
+	friends, err := f.store.GetFriends(req.OpUserID)
+	if err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	for _, fr := range friends {
+		resp.FriendList = append(resp.FriendList, &rpc.FriendInfo{
+			OwnerUserID:  fr.OwnerUserID,
+			FriendUserID: fr.FriendUserID,
+			Remark:       fr.Remark,
+			CreateTime:   fr.CreateTime,
+		})
+	}
+	return &resp, nil
+}
+
+func (f *Friend) GetFriendApplicationList(ctx context.Context, req *rpc.GetFriendApplicationListReq) (*rpc.GetFriendApplicationListResp, error) {
+	resp := rpc.GetFriendApplicationListResp{}
+	applies, err := f.store.GetApplications(req.OpUserID)
+	if err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	for _, a := range applies {
+		resp.ApplicationList = append(resp.ApplicationList, &rpc.FriendApplicationInfo{
+			FromUserID:   a.FromUserID,
+			ToUserID:     a.ToUserID,
+			ReqMsg:       a.ReqMsg,
+			HandleResult: a.HandleResult,
+			HandleMsg:    a.HandleMsg,
+			CreateTime:   a.CreateTime,
+			HandleTime:   a.HandleTime,
+		})
+	}
+	return &resp, nil
+}
+
+// 拉黑只通知自己的其他端，不通知被拉黑的用户
+func (f *Friend) AddBlack(ctx context.Context, req *rpc.AddBlackReq) (*rpc.FriendCommonResp, error) {
+	if req.OpUserID == "" || req.BlackUserID == "" || req.OpUserID == req.BlackUserID {
+		return friendResp(201, "args err")
+	}
+	err := f.store.AddBlack(&friend.Black{
+		OwnerUserID: req.OpUserID,
+		BlockUserID: req.BlackUserID,
+		CreateTime:  utils.GetCurrentTimestampByMill(),
+	})
+	if err != nil {
+		f.log.Error("add black failed", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("err", err.Error()))
+		return friendResp(206, err.Error())
+	}
+	f.log.Info("add black", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("blackUserID", req.BlackUserID))
+	f.notify(req.OperationID, req.OpUserID, req.OpUserID, constant.BlackAddedNotification, &rpc.FriendNotificationTips{FromUserID: req.OpUserID, ToUserID: req.BlackUserID})
+	return friendResp(0, "")
+}
+
+func (f *Friend) RemoveBlack(ctx context.Context, req *rpc.RemoveBlackReq) (*rpc.FriendCommonResp, error) {
+	if req.OpUserID == "" || req.BlackUserID == "" {
+		return friendResp(201, "args err")
+	}
+	if err := f.store.RemoveBlack(req.OpUserID, req.BlackUserID); err != nil {
+		f.log.Error("remove black failed", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("err", err.Error()))
+		return friendResp(206, err.Error())
+	}
+	f.log.Info("remove black", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.String("blackUserID", req.BlackUserID))
+	f.notify(req.OperationID, req.OpUserID, req.OpUserID, constant.BlackDeletedNotification, &rpc.FriendNotificationTips{FromUserID: req.OpUserID, ToUserID: req.BlackUserID})
+	return friendResp(0, "")
+}
+
+func (f *Friend) GetBlackList(ctx context.Context, req *rpc.GetBlackListReq) (*rpc.GetBlackListResp, error) {
+	resp := rpc.GetBlackListResp{}
+	blacks, err := f.store.GetBlackList(req.OpUserID)
+	if err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	for _, b := range blacks {
+		resp.BlackUserIDs = append(resp.BlackUserIDs, b.BlockUserID)
+	}
+	return &resp, nil
+}
+
+func (f *Friend) CheckRelation(ctx context.Context, req *rpc.CheckRelationReq) (*rpc.CheckRelationResp, error) {
+	resp := rpc.CheckRelationResp{}
+	var err error
+	if resp.FriendFlag, err = friend.FriendFlag(f.store, req.ToUserID, req.FromUserID); err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	if resp.BlackFlag, err = friend.BlackFlag(f.store, req.ToUserID, req.FromUserID); err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	if resp.RequireFriend, err = f.store.RequireFriend(req.ToUserID); err != nil {
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	return &resp, nil
+}
+
+func (f *Friend) SetRequireFriend(ctx context.Context, req *rpc.SetRequireFriendReq) (*rpc.FriendCommonResp, error) {
+	if req.OpUserID == "" {
+		return friendResp(201, "args err")
+	}
+	if err := f.store.SetRequireFriend(req.OpUserID, req.RequireFriend); err != nil {
+		return friendResp(206, err.Error())
+	}
+	f.log.Info("set require friend", zap.String("operationID", req.OperationID), zap.String("opUserID", req.OpUserID), zap.Bool("requireFriend", req.RequireFriend))
+	return friendResp(0, "")
+}
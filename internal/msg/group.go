@@ -0,0 +1,303 @@
+package msg
+
+import (
+	"context"
+	"insight/internal/group"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// 群管理
+// 群的变更都会通过消息投递流程发送对应的群通知
+type Group struct {
+	store group.Store
+	chat  *Chat
+	log   *zap.Logger
+	rpc.UnimplementedGroupServer
+}
+
+func NewGroupServer(log *zap.Logger, store group.Store, chat *Chat) *Group {
+	return &Group{store: store, chat: chat, log: log}
+}
+
+func groupResp(errCode int32, errMsg string) (*rpc.GroupCommonResp, error) {
+	return &rpc.GroupCommonResp{ErrCode: errCode, ErrMsg: errMsg}, nil
+}
+
+// 获取未解散的群和操作者的成员信息
+func (g *Group) getGroupAndOperator(groupID, opUserID string) (*group.Group, *group.Member, int32, string) {
+	grp, err := g.store.GetGroup(groupID)
+	if err != nil {
+		return nil, nil, 202, err.Error()
+	}
+	if grp.Status == constant.GroupStatusDismissed {
+		return nil, nil, 203, "group dismissed"
+	}
+	op, err := g.store.GetMember(groupID, opUserID)
+	if err != nil {
+		return nil, nil, 205, "not group member"
+	}
+	return grp, op, 0, ""
+}
+
+func isGroupManager(m *group.Member) bool {
+	return m.RoleLevel == constant.GroupOwner || m.RoleLevel == constant.GroupAdmin
+}
+
+// 操作者能否管理目标成员，群主能管理所有人，管理员只能管理普通成员
+func canManage(op, target *group.Member) bool {
+	if op.RoleLevel == constant.GroupOwner {
+		return target.RoleLevel != constant.GroupOwner
+	}
+	return op.RoleLevel == constant.GroupAdmin && target.RoleLevel == constant.GroupOrdinaryUsers
+}
+
+// 发送群通知
+func (g *Group) notify(operationID, groupID, opUserID string, contentType int32, tips *rpc.GroupNotificationTips, extraUserIDs []string) {
+	tips.GroupID = groupID
+	tips.OpUserID = opUserID
+	tips.OperationTime = utils.GetCurrentTimestampByMill()
+	data, err := newNotificationMsg(opUserID, constant.GroupChatType, contentType, tips)
+	if err != nil {
+		g.log.Error("new group notification failed", zap.String("operationID", operationID), zap.String("groupID", groupID), zap.String("err", err.Error()))
+		return
+	}
+	g.chat.sendGroupNotification(operationID, groupID, data, extraUserIDs)
+}
+
+func (g *Group) CreateGroup(ctx context.Context, req *rpc.CreateGroupReq) (*rpc.CreateGroupResp, error) {
+	resp := rpc.CreateGroupResp{}
+	if req.OpUserID == "" {
+		resp.ErrCode = 201
+		resp.ErrMsg = "opUserID is empty"
+		return &resp, nil
+	}
+	groupID := req.GroupID
+	if groupID == "" {
+		groupID = utils.OperationIDGenerator()
+	}
+	now := utils.GetCurrentTimestampByMill()
+	members := []*group.Member{{GroupID: groupID, UserID: req.OpUserID, RoleLevel: constant.GroupOwner, JoinTime: now}}
+	for _, userID := range lo.Uniq(req.MemberUserIDs) {
+		if userID == req.OpUserID || userID == "" {
+			continue
+		}
+		members = append(members, &group.Member{GroupID: groupID, UserID: userID, RoleLevel: constant.GroupOrdinaryUsers, JoinTime: now})
+	}
+	err := g.store.CreateGroup(&group.Group{
+		GroupID:     groupID,
+		GroupName:   req.GroupName,
+		OwnerUserID: req.OpUserID,
+		Status:      constant.GroupOk,
+		CreateTime:  now,
+	}, members)
+	if err != nil {
+		g.log.Error("create group failed", zap.String("operationID", req.OperationID), zap.String("groupID", groupID), zap.String("err", err.Error()))
+		resp.ErrCode = 206
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	g.log.Info("create group", zap.String("operationID", req.OperationID), zap.String("groupID", groupID), zap.String("opUserID", req.OpUserID), zap.Int("members", len(members)))
+	g.notify(req.OperationID, groupID, req.OpUserID, constant.GroupCreatedNotification, &rpc.GroupNotificationTips{UserIDs: lo.Map(members, func(m *group.Member, _ int) string { return m.UserID })}, nil)
+	resp.GroupID = groupID
+	return &resp, nil
+}
+
+func (g *Group) DismissGroup(ctx context.Context, req *rpc.DismissGroupReq) (*rpc.GroupCommonResp, error) {
+	grp, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	if op.RoleLevel != constant.GroupOwner {
+		return groupResp(204, "only group owner can dismiss group")
+	}
+	//先通知再修改状态，解散后的群不能再投递消息
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.GroupDismissedNotification, &rpc.GroupNotificationTips{}, nil)
+	grp.Status = constant.GroupStatusDismissed
+	if err := g.store.UpdateGroup(grp); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("dismiss group", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.String("opUserID", req.OpUserID))
+	return groupResp(0, "")
+}
+
+func (g *Group) InviteUserToGroup(ctx context.Context, req *rpc.InviteUserToGroupReq) (*rpc.GroupCommonResp, error) {
+	_, _, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	now := utils.GetCurrentTimestampByMill()
+	var members []*group.Member
+	var invitedUserIDs []string
+	for _, userID := range lo.Uniq(req.InvitedUserIDs) {
+		if _, err := g.store.GetMember(req.GroupID, userID); err == nil || userID == "" {
+			continue
+		}
+		members = append(members, &group.Member{GroupID: req.GroupID, UserID: userID, RoleLevel: constant.GroupOrdinaryUsers, JoinTime: now})
+		invitedUserIDs = append(invitedUserIDs, userID)
+	}
+	if len(members) == 0 {
+		return groupResp(201, "no user to invite")
+	}
+	if err := g.store.AddMembers(req.GroupID, members); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("invite user to group", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.Strings("invitedUserIDs", invitedUserIDs))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.MemberInvitedNotification, &rpc.GroupNotificationTips{UserIDs: invitedUserIDs}, nil)
+	return groupResp(0, "")
+}
+
+func (g *Group) KickGroupMember(ctx context.Context, req *rpc.KickGroupMemberReq) (*rpc.GroupCommonResp, error) {
+	_, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	var kickedUserIDs []string
+	for _, userID := range lo.Uniq(req.KickedUserIDs) {
+		target, err := g.store.GetMember(req.GroupID, userID)
+		if err != nil {
+			continue
+		}
+		if !canManage(op, target) {
+			return groupResp(204, "no permission to kick "+userID)
+		}
+		kickedUserIDs = append(kickedUserIDs, userID)
+	}
+	if len(kickedUserIDs) == 0 {
+		return groupResp(201, "no member to kick")
+	}
+	if err := g.store.RemoveMembers(req.GroupID, kickedUserIDs); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("kick group member", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.Strings("kickedUserIDs", kickedUserIDs))
+	//被踢的用户已不是成员，需要单独通知
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.MemberKickedNotification, &rpc.GroupNotificationTips{UserIDs: kickedUserIDs}, kickedUserIDs)
+	return groupResp(0, "")
+}
+
+func (g *Group) QuitGroup(ctx context.Context, req *rpc.QuitGroupReq) (*rpc.GroupCommonResp, error) {
+	_, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	if op.RoleLevel == constant.GroupOwner {
+		return groupResp(204, "group owner can not quit, transfer owner or dismiss group first")
+	}
+	if err := g.store.RemoveMembers(req.GroupID, []string{req.OpUserID}); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("quit group", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.String("opUserID", req.OpUserID))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.MemberQuitNotification, &rpc.GroupNotificationTips{UserIDs: []string{req.OpUserID}}, []string{req.OpUserID})
+	return groupResp(0, "")
+}
+
+func (g *Group) TransferGroupOwner(ctx context.Context, req *rpc.TransferGroupOwnerReq) (*rpc.GroupCommonResp, error) {
+	grp, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	if op.RoleLevel != constant.GroupOwner {
+		return groupResp(204, "only group owner can transfer owner")
+	}
+	newOwner, err := g.store.GetMember(req.GroupID, req.NewOwnerUserID)
+	if err != nil || newOwner.UserID == op.UserID {
+		return groupResp(205, "new owner is not group member")
+	}
+	op.RoleLevel = constant.GroupOrdinaryUsers
+	newOwner.RoleLevel = constant.GroupOwner
+	grp.OwnerUserID = newOwner.UserID
+	for _, m := range []*group.Member{op, newOwner} {
+		if err := g.store.UpdateMember(m); err != nil {
+			return groupResp(206, err.Error())
+		}
+	}
+	if err := g.store.UpdateGroup(grp); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("transfer group owner", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.String("opUserID", req.OpUserID), zap.String("newOwnerUserID", req.NewOwnerUserID))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.GroupOwnerTransferredNotification, &rpc.GroupNotificationTips{UserIDs: []string{newOwner.UserID}, RoleLevel: constant.GroupOwner}, nil)
+	return groupResp(0, "")
+}
+
+func (g *Group) SetGroupMemberRole(ctx context.Context, req *rpc.SetGroupMemberRoleReq) (*rpc.GroupCommonResp, error) {
+	_, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	if req.RoleLevel != constant.GroupOrdinaryUsers && req.RoleLevel != constant.GroupAdmin {
+		return groupResp(201, "roleLevel err")
+	}
+	if op.RoleLevel != constant.GroupOwner {
+		return groupResp(204, "only group owner can set member role")
+	}
+	target, err := g.store.GetMember(req.GroupID, req.UserID)
+	if err != nil {
+		return groupResp(205, "not group member")
+	}
+	if !canManage(op, target) {
+		return groupResp(204, "no permission")
+	}
+	target.RoleLevel = req.RoleLevel
+	if err := g.store.UpdateMember(target); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("set group member role", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.String("userID", req.UserID), zap.Int32("roleLevel", req.RoleLevel))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, constant.GroupMemberInfoSetNotification, &rpc.GroupNotificationTips{UserIDs: []string{req.UserID}, RoleLevel: req.RoleLevel}, nil)
+	return groupResp(0, "")
+}
+
+func (g *Group) MuteGroupMember(ctx context.Context, req *rpc.MuteGroupMemberReq) (*rpc.GroupCommonResp, error) {
+	_, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	target, err := g.store.GetMember(req.GroupID, req.UserID)
+	if err != nil {
+		return groupResp(205, "not group member")
+	}
+	if !canManage(op, target) {
+		return groupResp(204, "no permission")
+	}
+	contentType := int32(constant.GroupMemberCancelMutedNotification)
+	target.MuteEndTime = 0
+	if req.MutedSeconds > 0 {
+		contentType = constant.GroupMemberMutedNotification
+		target.MuteEndTime = utils.GetCurrentTimestampByMill() + int64(req.MutedSeconds)*1000
+	}
+	if err := g.store.UpdateMember(target); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("mute group member", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.String("userID", req.UserID), zap.Uint32("mutedSeconds", req.MutedSeconds))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, contentType, &rpc.GroupNotificationTips{UserIDs: []string{req.UserID}, MuteEndTime: target.MuteEndTime}, nil)
+	return groupResp(0, "")
+}
+
+func (g *Group) MuteGroup(ctx context.Context, req *rpc.MuteGroupReq) (*rpc.GroupCommonResp, error) {
+	grp, op, errCode, errMsg := g.getGroupAndOperator(req.GroupID, req.OpUserID)
+	if errCode != 0 {
+		return groupResp(errCode, errMsg)
+	}
+	if !isGroupManager(op) {
+		return groupResp(204, "only group owner or admin can mute group")
+	}
+	//被封禁的群不能通过禁言、解禁来改变状态，只在正常和全员禁言之间切换
+	if grp.Status == constant.GroupBanChat {
+		return groupResp(207, "group is banned")
+	}
+	contentType := int32(constant.GroupCancelMutedNotification)
+	grp.Status = constant.GroupOk
+	if req.IsMute {
+		contentType = constant.GroupMutedNotification
+		grp.Status = constant.GroupStatusMuted
+	}
+	if err := g.store.UpdateGroup(grp); err != nil {
+		return groupResp(206, err.Error())
+	}
+	g.log.Info("mute group", zap.String("operationID", req.OperationID), zap.String("groupID", req.GroupID), zap.Bool("isMute", req.IsMute))
+	g.notify(req.OperationID, req.GroupID, req.OpUserID, contentType, &rpc.GroupNotificationTips{}, nil)
+	return groupResp(0, "")
+}
@@ -0,0 +1,49 @@
+package msg
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// 服务端消息id生成器
+// id由毫秒时间戳、同一毫秒内的序号和进程随机标识组成，按字符串排序即按生成时间排序
+type msgIDGenerator struct {
+	mutex  sync.Mutex
+	lastMs int64
+	seq    uint32
+	node   string
+}
+
+func newMsgIDGenerator() *msgIDGenerator {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return &msgIDGenerator{node: hex.EncodeToString(b)}
+}
+
+// 生成消息id，同时返回生成时的毫秒时间戳作为消息的发送时间
+func (g *msgIDGenerator) next() (string, int64) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	now := time.Now().UnixMilli()
+	//时钟回拨时沿用上次的时间戳，保证id递增
+	if now <= g.lastMs {
+		now = g.lastMs
+		g.seq++
+		//同一毫秒内序号用完，借用下一毫秒
+		if g.seq > 0xffff {
+			now++
+			g.seq = 0
+		}
+	} else {
+		g.seq = 0
+	}
+	g.lastMs = now
+	return fmt.Sprintf("%012x%04x%s", now, g.seq, g.node), now
+}
+
+var serverMsgID = newMsgIDGenerator()
@@ -0,0 +1,69 @@
+package msg
+
+import (
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// 构造系统通知消息，tips序列化后放在content中
+func newNotificationMsg(sendID string, sessionType, contentType int32, tips proto.Message) (*msg.MsgData, error) {
+	content, err := proto.Marshal(tips)
+	if err != nil {
+		return nil, err
+	}
+	msgID, now := serverMsgID.next()
+	data := msg.MsgData{
+		SendID:      sendID,
+		ClientMsgID: msgID,
+		ServerMsgID: msgID,
+		SessionType: sessionType,
+		MsgFrom:     constant.SysMsgType,
+		ContentType: contentType,
+		Content:     content,
+		SendTime:    now,
+		CreateTime:  now,
+		Status:      constant.MsgNormal,
+	}
+	fillOptions(&data)
+	return &data, nil
+}
+
+// 发送群通知，走消息投递流程但不做token和发言权限检查
+// 通知写入群时间线，并投递给当前群成员和extraUserIDs(如被踢、退群的用户)
+func (c *Chat) sendGroupNotification(operationID, groupID string, data *msg.MsgData, extraUserIDs []string) {
+	data.GroupID = groupID
+	memberIDs, err := c.groups.GetMemberIDs(groupID)
+	if err != nil {
+		c.log.Error("get group members failed", zap.String("operationID", operationID), zap.String("groupId", groupID), zap.String("err", err.Error()))
+		return
+	}
+	req := msg.SendMsgReq{OperationID: operationID, Data: data}
+	if err := c.deliverGroupMsg(&req, lo.Uniq(append(memberIDs, extraUserIDs...)), nil); err != nil {
+		c.log.Error("send group notification failed", zap.String("operationID", operationID), zap.String("groupId", groupID), zap.Int32("contentType", data.ContentType))
+	}
+}
+
+// 发送单人通知，不经过SendMsg，直接投递给recvID并同步给发送者的其他端
+// recvID与sendID相同时只投递一份
+func (c *Chat) sendNotification(operationID, sendID, recvID string, contentType int32, tips proto.Message) {
+	data, err := newNotificationMsg(sendID, constant.NotificationChatType, contentType, tips)
+	if err != nil {
+		c.log.Error("new notification failed", zap.String("operationID", operationID), zap.Int32("contentType", contentType), zap.String("err", err.Error()))
+		return
+	}
+	data.RecvID = recvID
+	req := msg.SendMsgReq{OperationID: operationID, Data: data}
+	if err := c.deliverToInbox(&req, recvID); err != nil {
+		c.log.Error("send notification failed", zap.String("operationID", operationID), zap.String("recvId", recvID), zap.Int32("contentType", contentType), zap.String("err", err.Error()))
+		return
+	}
+	if sendID != recvID {
+		if err := c.deliverToSender(&req); err != nil {
+			c.log.Error("send notification failed", zap.String("operationID", operationID), zap.String("sendId", sendID), zap.Int32("contentType", contentType), zap.String("err", err.Error()))
+		}
+	}
+}
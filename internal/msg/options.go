@@ -0,0 +1,56 @@
+package msg
+
+import (
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+)
+
+// 各消息类型的默认选项，客户端未设置的选项使用默认值，未列出的选项默认开启
+var contentTypeOptions = map[int32]map[string]bool{
+	//输入状态只推送在线用户，不存储也不影响会话
+	constant.Typing: {
+		constant.IsHistory:                  false,
+		constant.IsPersistent:               false,
+		constant.IsOfflinePush:              false,
+		constant.IsUnreadCount:              false,
+		constant.IsConversationUpdate:       false,
+		constant.IsSenderConversationUpdate: false,
+		constant.IsSenderSync:               false,
+	},
+	constant.HasReadReceipt: {
+		constant.IsOfflinePush:              false,
+		constant.IsUnreadCount:              false,
+		constant.IsConversationUpdate:       false,
+		constant.IsSenderConversationUpdate: false,
+	},
+	constant.Revoke: {
+		constant.IsOfflinePush: false,
+		constant.IsUnreadCount: false,
+	},
+}
+
+// 系统通知的默认选项
+var notificationOptions = map[string]bool{
+	constant.IsOfflinePush:              false,
+	constant.IsUnreadCount:              false,
+	constant.IsSenderConversationUpdate: false,
+}
+
+func defaultOptions(contentType int32) map[string]bool {
+	if contentType > constant.NotificationBegin && contentType < constant.NotificationEnd {
+		return notificationOptions
+	}
+	return contentTypeOptions[contentType]
+}
+
+// 填充消息选项，客户端已设置的选项保持不变
+func fillOptions(data *msg.MsgData) {
+	if data.Options == nil {
+		data.Options = make(map[string]bool)
+	}
+	for key, flag := range defaultOptions(data.ContentType) {
+		if _, ok := data.Options[key]; !ok {
+			data.Options[key] = flag
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package msg
+
+import (
+	"context"
+	"errors"
+	"insight/internal/seq"
+	"insight/internal/storage"
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+	"insight/pkg/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// 发送已读回执
+// 单聊回执投递给对方和自己的其他端，群聊回执只记录已读位置并同步自己的其他端，发送者通过GetMsgReadStatus查询
+// 禁言不影响已读上报，因此不走发言权限检查
+func (c *Chat) sendReadReceipt(req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+	resp := msg.SendMsgResp{}
+	notifyPeer := req.Data.SessionType == constant.SingleChatType && req.Data.SendID != req.Data.RecvID
+	if notifyPeer {
+		if errCode, errMsg := c.checkSingleSend(req.Data); errCode != 0 {
+			return returnMsg(&resp, req, errCode, errMsg, "", 0)
+		}
+	}
+	if errCode, errMsg := c.markRead(req.Data); errCode != 0 {
+		c.log.Error("mark read failed", zap.String("operationID", req.OperationID), zap.String("sendId", req.Data.SendID), zap.String("errMsg", errMsg))
+		return returnMsg(&resp, req, errCode, errMsg, "", 0)
+	}
+	if notifyPeer {
+		if err := c.deliverToInbox(req, req.Data.RecvID); err != nil {
+			return returnMsg(&resp, req, 201, "kfka send msg err", "", 0)
+		}
+	}
+	//对方已经收到回执时，同步失败改为后台重试，避免客户端重发
+	if err := c.deliverToSender(req); err != nil {
+		if !notifyPeer {
+			return returnMsg(&resp, req, 201, "kfka send msg err", "", 0)
+		}
+		c.redeliver(req, []string{req.Data.SendID}, func(string) error { return c.deliverToSender(req) })
+	}
+	return returnMsg(&resp, req, 0, "", req.Data.ServerMsgID, req.Data.SendTime)
+}
+
+// 记录已读位置，并由服务端填充回执内容
+func (c *Chat) markRead(data *msg.MsgData) (errCode int32, errMsg string) {
+	content := msg.ReadReceiptContent{}
+	if err := proto.Unmarshal(data.Content, &content); err != nil || content.ServerMsgID == "" {
+		return 219, "read receipt content err"
+	}
+	var key, conversationID string
+	switch data.SessionType {
+	case constant.SingleChatType:
+		key = data.SendID
+		conversationID = utils.GetConversationIDByMsg(data, data.SendID)
+	case constant.GroupChatType:
+		if _, err := c.groups.GetMember(data.GroupID, data.SendID); err != nil {
+			return 206, "not group member"
+		}
+		key = seq.GroupKey(data.GroupID)
+		conversationID = utils.GetConversationIDByMsg(data, data.SendID)
+	default:
+		return 219, "read receipt sessionType err"
+	}
+	target, err := c.store.GetByServerMsgID(key, content.ServerMsgID)
+	if errors.Is(err, storage.ErrMsgNotExist) {
+		return 220, "read msg not exist"
+	}
+	if err != nil {
+		return 220, err.Error()
+	}
+	//单聊的消息必须属于和对方的会话
+	if data.SessionType == constant.SingleChatType && target.SendID != data.RecvID && target.RecvID != data.RecvID {
+		return 220, "read msg not in conversation"
+	}
+	if err := c.receipts.SetHasReadSeq(conversationID, data.SendID, target.Seq); err != nil {
+		return 220, err.Error()
+	}
+	content.HasReadSeq = target.Seq
+	content.MsgSendTime = target.SendTime
+	content.ReadTime = data.SendTime
+	b, err := proto.Marshal(&content)
+	if err != nil {
+		return 219, err.Error()
+	}
+	data.Content = b
+	return 0, ""
+}
+
+// 查询消息的已读状态，只有消息发送者可以查询
+func (c *Chat) GetMsgReadStatus(ctx context.Context, req *msg.GetMsgReadStatusReq) (*msg.GetMsgReadStatusResp, error) {
+	resp := msg.GetMsgReadStatusResp{}
+	var key, conversationID string
+	var userIDs []string
+	switch req.SessionType {
+	case constant.SingleChatType:
+		//在接收者的收件箱中查找，和接收者的已读seq比较
+		key = req.RecvID
+		conversationID = utils.GetConversationIDBySessionType(req.UserID, req.SessionType)
+		userIDs = []string{req.RecvID}
+	case constant.GroupChatType:
+		memberIDs, err := c.groups.GetMemberIDs(req.GroupID)
+		if err != nil {
+			resp.ErrCode = 204
+			resp.ErrMsg = err.Error()
+			return &resp, nil
+		}
+		key = seq.GroupKey(req.GroupID)
+		conversationID = utils.GetConversationIDBySessionType(req.GroupID, req.SessionType)
+		userIDs = memberIDs
+	default:
+		resp.ErrCode = 203
+		resp.ErrMsg = "unkonwn sessionType"
+		return &resp, nil
+	}
+	target, err := c.store.GetByServerMsgID(key, req.ServerMsgID)
+	if err != nil {
+		resp.ErrCode = 220
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	if target.SendID != req.UserID {
+		resp.ErrCode = 216
+		resp.ErrMsg = "only sender can get read status"
+		return &resp, nil
+	}
+	seqs, err := c.receipts.GetHasReadSeqs(conversationID)
+	if err != nil {
+		c.log.Error("get has read seqs failed", zap.String("operationID", req.OperationID), zap.String("conversationID", conversationID), zap.String("err", err.Error()))
+		resp.ErrCode = 220
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	for _, userID := range userIDs {
+		if userID == req.UserID {
+			continue
+		}
+		if seqs[userID] >= target.Seq {
+			resp.ReadUserIDs = append(resp.ReadUserIDs, userID)
+		} else {
+			resp.UnreadUserIDs = append(resp.UnreadUserIDs, userID)
+		}
+	}
+	return &resp, nil
+}
@@ -0,0 +1,113 @@
+package msg
+
+import (
+	"insight/internal/content"
+	"insight/internal/seq"
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 引用和合并转发消息中的消息快照以存储为准，客户端只需要提供serverMsgID
+// 发送者只能引用或转发自己能看到的消息，快照会被替换为存储中的消息，防止伪造内容
+func (c *Chat) resolveRefs(data *msg.MsgData) (errCode int32, errMsg string) {
+	switch data.ContentType {
+	case constant.Quote:
+		return c.resolveQuote(data)
+	case constant.Merger:
+		return c.resolveMerger(data)
+	}
+	return 0, ""
+}
+
+func (c *Chat) resolveQuote(data *msg.MsgData) (errCode int32, errMsg string) {
+	quote := msg.QuoteContent{}
+	if err := proto.Unmarshal(data.Content, &quote); err != nil || quote.QuoteMessage == nil {
+		return content.ErrCodeQuote, "quote content err"
+	}
+	target := c.findVisibleMsg(data.SendID, quote.QuoteMessage)
+	if target == nil {
+		return content.ErrCodeQuoteMsg, "quote msg not exist"
+	}
+	if target.Status == constant.MsgRevoked {
+		return content.ErrCodeQuoteMsg, "quote msg revoked"
+	}
+	if !inConversation(data, target) {
+		return content.ErrCodeQuoteMsg, "quote msg not in conversation"
+	}
+	quote.QuoteMessage = snapshot(target)
+	b, err := proto.Marshal(&quote)
+	if err != nil {
+		return content.ErrCodeQuote, err.Error()
+	}
+	data.Content = b
+	return 0, ""
+}
+
+func (c *Chat) resolveMerger(data *msg.MsgData) (errCode int32, errMsg string) {
+	merger := msg.MergerContent{}
+	if err := proto.Unmarshal(data.Content, &merger); err != nil {
+		return content.ErrCodeMerger, "merger content err"
+	}
+	for i, ref := range merger.MultiMessage {
+		target := c.findVisibleMsg(data.SendID, ref)
+		if target == nil {
+			return content.ErrCodeMergerMsg, "merger msg not exist: " + ref.ServerMsgID
+		}
+		if target.Status == constant.MsgRevoked {
+			return content.ErrCodeMergerMsg, "merger msg revoked: " + ref.ServerMsgID
+		}
+		merger.MultiMessage[i] = snapshot(target)
+	}
+	b, err := proto.Marshal(&merger)
+	if err != nil {
+		return content.ErrCodeMerger, err.Error()
+	}
+	data.Content = b
+	return 0, ""
+}
+
+// 查找用户能看到的消息，先查用户自己的收件箱，群消息再查用户所在群的时间线
+func (c *Chat) findVisibleMsg(userID string, ref *msg.MsgData) *msg.MsgData {
+	if ref == nil || ref.ServerMsgID == "" {
+		return nil
+	}
+	if target, err := c.store.GetByServerMsgID(userID, ref.ServerMsgID); err == nil {
+		return target
+	}
+	if ref.SessionType != constant.GroupChatType || ref.GroupID == "" {
+		return nil
+	}
+	if _, err := c.groups.GetMember(ref.GroupID, userID); err != nil {
+		return nil
+	}
+	target, err := c.store.GetByServerMsgID(seq.GroupKey(ref.GroupID), ref.ServerMsgID)
+	if err != nil {
+		return nil
+	}
+	return target
+}
+
+// 被引用的消息是否属于当前会话
+func inConversation(data, target *msg.MsgData) bool {
+	if data.SessionType != target.SessionType {
+		return false
+	}
+	switch data.SessionType {
+	case constant.SingleChatType:
+		return (target.SendID == data.SendID && target.RecvID == data.RecvID) ||
+			(target.SendID == data.RecvID && target.RecvID == data.SendID)
+	case constant.GroupChatType:
+		return target.GroupID == data.GroupID
+	}
+	return false
+}
+
+// 消息快照，去掉收件箱相关的字段
+func snapshot(target *msg.MsgData) *msg.MsgData {
+	s := proto.Clone(target).(*msg.MsgData)
+	s.Seq = 0
+	s.Options = nil
+	return s
+}
@@ -0,0 +1,76 @@
+package msg
+
+import (
+	"errors"
+	"insight/internal/group"
+	"insight/internal/seq"
+	"insight/internal/storage"
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 撤回消息检查
+// 发送者可以撤回自己的消息，群主和管理员可以撤回其管理的成员的消息，都需要在撤回窗口内
+// 检查通过后由服务端填充撤回者和撤回时间，各收件箱中被撤回消息的状态由transfer修改
+func (c *Chat) checkRevoke(data *msg.MsgData) (errCode int32, errMsg string) {
+	content := msg.RevokeContent{}
+	if err := proto.Unmarshal(data.Content, &content); err != nil || content.ServerMsgID == "" {
+		return 214, "revoke content err"
+	}
+	var key string
+	switch data.SessionType {
+	case constant.SingleChatType:
+		//单聊只能撤回自己发给对方的消息，在对方的收件箱中查找
+		key = data.RecvID
+	case constant.GroupChatType:
+		key = seq.GroupKey(data.GroupID)
+	default:
+		return 214, "revoke sessionType err"
+	}
+	target, err := c.store.GetByServerMsgID(key, content.ServerMsgID)
+	if errors.Is(err, storage.ErrMsgNotExist) {
+		return 215, "revoke msg not exist"
+	}
+	if err != nil {
+		return 215, err.Error()
+	}
+	if target.MsgFrom == constant.SysMsgType || target.ContentType == constant.Revoke {
+		return 216, "msg can not be revoked"
+	}
+	if target.Status == constant.MsgRevoked {
+		return 217, "msg already revoked"
+	}
+	if target.SendID != data.SendID && !c.canRevokeOthers(data, target) {
+		return 216, "no permission to revoke"
+	}
+	if c.revokeWindow > 0 && data.SendTime-target.SendTime > c.revokeWindow*1000 {
+		return 218, "revoke time exceeded"
+	}
+	content.RevokerID = data.SendID
+	content.RevokeTime = data.SendTime
+	b, err := proto.Marshal(&content)
+	if err != nil {
+		return 214, err.Error()
+	}
+	data.Content = b
+	return 0, ""
+}
+
+// 群主和管理员撤回他人消息
+func (c *Chat) canRevokeOthers(data, target *msg.MsgData) bool {
+	if data.SessionType != constant.GroupChatType {
+		return false
+	}
+	op, err := c.groups.GetMember(data.GroupID, data.SendID)
+	if err != nil {
+		return false
+	}
+	sender, err := c.groups.GetMember(data.GroupID, target.SendID)
+	if err != nil {
+		//发送者已退群，按普通成员处理
+		sender = &group.Member{GroupID: data.GroupID, UserID: target.SendID, RoleLevel: constant.GroupOrdinaryUsers}
+	}
+	return canManage(op, sender)
+}
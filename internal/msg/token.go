@@ -0,0 +1,121 @@
+package msg
+
+import (
+	"context"
+	"insight/internal/kick"
+	"insight/internal/seq"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"insight/pkg/common/token"
+	rpc "insight/pkg/proto/msg"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// token 签发与吊销
+// 签名有效且未过期的token即为有效，踢人时只记录踢下线的时间，签发时间不晚于该时间的token置为 constant.KickedToken
+type Token struct {
+	signer   token.Signer
+	verifier token.Verifier
+	log      *zap.Logger
+	kicks    kick.Store
+	rpc.UnimplementedTokenServer
+}
+
+func NewTokenServer(cfg *config.MsgConfig, log *zap.Logger) (*Token, error) {
+	signer, err := token.NewSigner(cfg.TokenCfg)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := token.NewVerifier(cfg.TokenCfg)
+	if err != nil {
+		return nil, err
+	}
+	kicks, err := kick.NewStore(cfg.KickCfg)
+	if err != nil {
+		return nil, err
+	}
+	t := Token{
+		signer:   signer,
+		verifier: verifier,
+		log:      log,
+		kicks:    kicks,
+	}
+	return &t, nil
+}
+
+func (t *Token) IssueToken(ctx context.Context, req *rpc.IssueTokenReq) (*rpc.IssueTokenResp, error) {
+	resp := rpc.IssueTokenResp{}
+	if req.UserID == "" || constant.PlatformIDToName(req.PlatformID) == "" {
+		resp.ErrCode = 201
+		resp.ErrMsg = "userID or platformID err"
+		return &resp, nil
+	}
+	//用户收件箱直接使用用户id作为key，不能和群时间线的key冲突
+	if seq.IsGroupKey(req.UserID) {
+		resp.ErrCode = 201
+		resp.ErrMsg = "userID uses reserved prefix"
+		return &resp, nil
+	}
+	tokenString, expireTime, err := t.signer.Sign(req.UserID, req.PlatformID)
+	if err != nil {
+		t.log.Error("sign token failed", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.String("err", err.Error()))
+		resp.ErrCode = 202
+		resp.ErrMsg = err.Error()
+		return &resp, nil
+	}
+	t.log.Info("issue token", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.Int32("platformID", req.PlatformID), zap.Int64("expireTime", expireTime))
+
+	resp.Token = tokenString
+	resp.ExpireTime = expireTime
+	return &resp, nil
+}
+
+func (t *Token) GetTokenState(ctx context.Context, req *rpc.GetTokenStateReq) (*rpc.GetTokenStateResp, error) {
+	resp := rpc.GetTokenStateResp{}
+	claims, state := t.state(req.Token)
+	resp.State = state
+	if claims != nil {
+		resp.UserID = claims.UID
+		resp.PlatformID = claims.PlatformID
+	}
+	return &resp, nil
+}
+
+func (t *Token) KickToken(ctx context.Context, req *rpc.KickTokenReq) (*rpc.KickTokenResp, error) {
+	r := kick.Record{Time: time.Now().Unix(), Except: req.ExceptToken}
+	platformIDs := req.PlatformIDs
+	if len(platformIDs) == 0 {
+		platformIDs = []int32{kick.AllPlatform}
+	}
+	for _, platformID := range platformIDs {
+		if err := t.kicks.Set(req.UserID, platformID, r); err != nil {
+			t.log.Error("kick token failed", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.Int32("platformID", platformID), zap.String("err", err.Error()))
+			return &rpc.KickTokenResp{ErrCode: 202, ErrMsg: err.Error()}, nil
+		}
+	}
+	t.log.Info("kick token", zap.String("operationID", req.OperationID), zap.String("userID", req.UserID), zap.Int32s("platformIDs", req.PlatformIDs))
+	return &rpc.KickTokenResp{}, nil
+}
+
+// 查询token状态，签名和有效期校验通过后再检查是否被踢下线
+func (t *Token) state(tokenString string) (*token.Claims, int32) {
+	claims, err := t.verifier.Verify(tokenString)
+	if err != nil {
+		return nil, token.State(err)
+	}
+	records, err := t.kicks.Get(claims.UID)
+	if err != nil {
+		t.log.Error("get kick records failed", zap.String("userID", claims.UID), zap.String("err", err.Error()))
+		return claims, constant.InValidToken
+	}
+	var issuedAt int64
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Unix()
+	}
+	if kick.Kicked(records, claims.PlatformID, issuedAt, tokenString) {
+		return claims, constant.KickedToken
+	}
+	return claims, constant.NormalToken
+}
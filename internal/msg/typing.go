@@ -0,0 +1,83 @@
+package msg
+
+import (
+	"context"
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
+	"insight/pkg/utils"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 同一会话发送输入状态的最小间隔
+const typingInterval = time.Second
+
+// 输入状态按会话限流，记录每个会话最近一次发送的时间
+type typingLimiter struct {
+	mutex sync.Mutex
+	last  map[string]time.Time
+}
+
+func newTypingLimiter() *typingLimiter {
+	return &typingLimiter{last: make(map[string]time.Time)}
+}
+
+func (l *typingLimiter) allow(sendID, recvID string) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := time.Now()
+	key := sendID + ":" + recvID
+	if last, ok := l.last[key]; ok && now.Sub(last) < typingInterval {
+		return false
+	}
+	//会话数量较多时清理已过间隔的记录
+	if len(l.last) > 10000 {
+		for k, t := range l.last {
+			if now.Sub(t) >= typingInterval {
+				delete(l.last, k)
+			}
+		}
+	}
+	l.last[key] = now
+	return true
+}
+
+// 发送输入状态
+// 输入状态是临时的，不经过kafka也不存储，直接通过网关推送给对方的在线连接，对方离线时丢弃
+func (c *Chat) sendTyping(req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+	resp := msg.SendMsgResp{}
+	if req.Data.SessionType != constant.SingleChatType || req.Data.SendID == req.Data.RecvID {
+		return returnMsg(&resp, req, 221, "typing only supports single chat", "", 0)
+	}
+	if errCode, errMsg := c.checkSingleSend(req.Data); errCode != 0 {
+		return returnMsg(&resp, req, errCode, errMsg, "", 0)
+	}
+	if !c.typing.allow(req.Data.SendID, req.Data.RecvID) {
+		return returnMsg(&resp, req, 222, "typing too frequent", "", 0)
+	}
+	//对方设置了不接收该会话时不推送
+	conversationID := utils.GetConversationIDByMsg(req.Data, req.Data.RecvID)
+	if opt, err := c.convs.GetRecvOpt(req.Data.RecvID, conversationID); err == nil && opt == constant.NotReceiveMessage {
+		return returnMsg(&resp, req, 0, "", req.Data.ServerMsgID, req.Data.SendTime)
+	}
+	go c.pushToGates(req.OperationID, req.Data.RecvID, req.Data)
+	return returnMsg(&resp, req, 0, "", req.Data.ServerMsgID, req.Data.SendTime)
+}
+
+// 推送给所有网关，只有持有用户连接的网关会真正下发
+func (c *Chat) pushToGates(operationID, userID string, data *msg.MsgData) {
+	for _, client := range c.gateClients {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		_, err := client.PushMsgToUser(ctx, &msg.PushMsgToUserReq{
+			OperationID: operationID,
+			UserID:      userID,
+			MsgData:     data,
+		})
+		cancel()
+		if err != nil {
+			c.log.Error("push msg to gate failed", zap.String("operationID", operationID), zap.String("userID", userID), zap.String("err", err.Error()))
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package push
+
+import (
+	"context"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	//通知描述的最大长度，单位字符
+	maxDescLen = 100
+	//推送队列长度，队列满时丢弃新的推送，避免阻塞kafka消费
+	queueSize = 1024
+	//推送worker数量
+	workerNum = 4
+	//单次推送超时时间
+	pushTimeout = 2 * time.Second
+)
+
+// 待推送的通知
+type job struct {
+	operationID string
+	n           *Notification
+}
+
+// 离线推送服务
+// 接收者没有在线连接时，根据消息的offlinePushInfo构造通知，放入队列由worker异步通过Pusher发送
+type Service struct {
+	pusher Pusher
+	log    *zap.Logger
+	jobs   chan job
+}
+
+func NewService(cfg *config.TransferConfig, log *zap.Logger) (*Service, error) {
+	pusher, err := NewPusher(cfg.PushCfg)
+	if err != nil {
+		return nil, err
+	}
+	s := Service{pusher: pusher, log: log}
+	if pusher != nil {
+		s.jobs = make(chan job, queueSize)
+		for i := 0; i < workerNum; i++ {
+			go s.work()
+		}
+	}
+	return &s, nil
+}
+
+func (s *Service) work() {
+	for j := range s.jobs {
+		ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+		err := s.pusher.Push(ctx, j.n)
+		cancel()
+		if err != nil {
+			s.log.Error("offline push failed", zap.String("operationID", j.operationID), zap.String("userID", j.n.UserID), zap.String("serverMsgID", j.n.ServerMsgID), zap.String("err", err.Error()))
+			continue
+		}
+		s.log.Info("offline push success", zap.String("operationID", j.operationID), zap.String("userID", j.n.UserID), zap.String("serverMsgID", j.n.ServerMsgID))
+	}
+}
+
+// 给离线用户推送消息，只入队不等待推送结果
+// 自己发送的消息、关闭了offlinePush的消息不推送
+// 会话接收选项由消息服务投递时处理，接收但不提醒的会话投递的消息已关闭offlinePush
+func (s *Service) Push(operationID, userID string, data *rpc.MsgData) {
+	if s.pusher == nil || data.SendID == userID {
+		return
+	}
+	if !utils.GetSwitchFromOptions(data.Options, constant.IsOfflinePush) {
+		return
+	}
+	select {
+	case s.jobs <- job{operationID: operationID, n: newNotification(userID, data)}:
+	default:
+		s.log.Error("offline push queue full, drop", zap.String("operationID", operationID), zap.String("userID", userID), zap.String("serverMsgID", data.ServerMsgID))
+	}
+}
+
+// 构造通知，客户端没有设置标题和描述时按消息类型生成
+func newNotification(userID string, data *rpc.MsgData) *Notification {
+	n := Notification{
+		UserID:         userID,
+		SendID:         data.SendID,
+		ConversationID: utils.GetConversationIDByMsg(data, userID),
+		GroupID:        data.GroupID,
+		SessionType:    data.SessionType,
+		ContentType:    data.ContentType,
+		ServerMsgID:    data.ServerMsgID,
+		SendTime:       data.SendTime,
+	}
+	if info := data.OfflinePushInfo; info != nil {
+		n.Title = info.Title
+		n.Desc = info.Desc
+		n.Ex = info.Ex
+		n.IOSPushSound = info.IOSPushSound
+		n.IOSBadge = info.IOSBadgeCount
+	}
+	if n.Title == "" {
+		n.Title = data.SenderNickname
+		if n.Title == "" {
+			n.Title = data.SendID
+		}
+	}
+	if n.Desc == "" {
+		n.Desc = defaultDesc(data)
+	}
+	return &n
+}
+
+var contentTypeDesc = map[int32]string{
+	constant.Picture:  "[图片]",
+	constant.Voice:    "[语音]",
+	constant.Video:    "[视频]",
+	constant.File:     "[文件]",
+	constant.Merger:   "[聊天记录]",
+	constant.Card:     "[名片]",
+	constant.Location: "[位置]",
+	constant.Custom:   "[自定义消息]",
+}
+
+func defaultDesc(data *rpc.MsgData) string {
+	var text string
+	switch data.ContentType {
+	case constant.Text:
+		content := rpc.TextContent{}
+		if proto.Unmarshal(data.Content, &content) == nil {
+			text = content.Text
+		}
+	case constant.AtText:
+		content := rpc.AtTextContent{}
+		if proto.Unmarshal(data.Content, &content) == nil {
+			text = content.Text
+			if content.IsAtSelf {
+				text = "[有人@你]" + text
+			}
+		}
+	case constant.Quote:
+		content := rpc.QuoteContent{}
+		if proto.Unmarshal(data.Content, &content) == nil {
+			text = content.Text
+		}
+	default:
+		text = contentTypeDesc[data.ContentType]
+	}
+	if text == "" {
+		return "你收到了一条新消息"
+	}
+	if utf8.RuneCountInString(text) > maxDescLen {
+		text = string([]rune(text)[:maxDescLen]) + "..."
+	}
+	return text
+}
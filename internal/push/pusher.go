@@ -0,0 +1,114 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"insight/pkg/common/config"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	//离线推送方式
+	ProviderNone = ""
+	ProviderFile = "file"
+	ProviderHTTP = "http"
+)
+
+// 离线推送通知
+type Notification struct {
+	UserID       string `json:"userID"`
+	Title        string `json:"title"`
+	Desc         string `json:"desc"`
+	Ex           string `json:"ex"`
+	IOSPushSound string `json:"iOSPushSound"`
+	IOSBadge     bool   `json:"iOSBadgeCount"`
+	SendID       string `json:"sendID"`
+	//接收者视角的会话id，与 utils.GetConversationIDBySessionType 一致
+	ConversationID string `json:"conversationID"`
+	GroupID        string `json:"groupID"`
+	SessionType    int32  `json:"sessionType"`
+	ContentType    int32  `json:"contentType"`
+	ServerMsgID    string `json:"serverMsgID"`
+	SendTime       int64  `json:"sendTime"`
+}
+
+// 离线推送厂商，如apns、fcm、个推等，按需实现
+type Pusher interface {
+	Push(ctx context.Context, n *Notification) error
+}
+
+func NewPusher(cfg config.Push) (Pusher, error) {
+	switch cfg.Provider {
+	case ProviderNone:
+		return nil, nil
+	case ProviderFile:
+		return NewFilePusher(cfg.File)
+	case ProviderHTTP:
+		return NewHTTPPusher(cfg.URL), nil
+	}
+	return nil, fmt.Errorf("unsupported push provider: %s", cfg.Provider)
+}
+
+// 写入本地文件，每行一条json，测试使用
+type filePusher struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func NewFilePusher(name string) (Pusher, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &filePusher{file: f}, nil
+}
+
+func (p *filePusher) Push(ctx context.Context, n *Notification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	_, err = p.file.Write(append(b, '\n'))
+	return err
+}
+
+// 以json post到指定地址，由外部服务对接实际的推送厂商
+type httpPusher struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPPusher(url string) Pusher {
+	return &httpPusher{url: url, client: &http.Client{}}
+}
+
+func (p *httpPusher) Push(ctx context.Context, n *Notification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("push http status: %d", resp.StatusCode)
+	}
+	return nil
+}
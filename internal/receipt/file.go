@@ -0,0 +1,107 @@
+package receipt
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 本地文件已读回执存储，每个会话一个文件，内容为 用户id -> 已读seq 的json
+// 内存中缓存已读取的会话，每次修改都会写回文件
+type fileStore struct {
+	dir   string
+	mutex sync.Mutex
+	seqs  map[string]map[string]uint32
+}
+
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, seqs: make(map[string]map[string]uint32)}, nil
+}
+
+// 会话id做hex编码避免出现路径分隔符
+func (s *fileStore) file(conversationID string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(conversationID)))
+}
+
+func (s *fileStore) get(conversationID string) (map[string]uint32, error) {
+	if seqs, ok := s.seqs[conversationID]; ok {
+		return seqs, nil
+	}
+	seqs := make(map[string]uint32)
+	data, err := os.ReadFile(s.file(conversationID))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &seqs); err != nil {
+			return nil, fmt.Errorf("parse receipt file of %s: %w", conversationID, err)
+		}
+	}
+	s.seqs[conversationID] = seqs
+	return seqs, nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (s *fileStore) save(conversationID string, seqs map[string]uint32) error {
+	data, err := json.Marshal(seqs)
+	if err != nil {
+		return err
+	}
+	name := s.file(conversationID)
+	if err := os.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (s *fileStore) SetHasReadSeq(conversationID, userID string, seq uint32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	seqs, err := s.get(conversationID)
+	if err != nil {
+		return err
+	}
+	if seq <= seqs[userID] {
+		return nil
+	}
+	next := make(map[string]uint32, len(seqs)+1)
+	for k, v := range seqs {
+		next[k] = v
+	}
+	next[userID] = seq
+	if err := s.save(conversationID, next); err != nil {
+		return err
+	}
+	s.seqs[conversationID] = next
+	return nil
+}
+
+func (s *fileStore) GetHasReadSeq(conversationID, userID string) (uint32, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	seqs, err := s.get(conversationID)
+	if err != nil {
+		return 0, err
+	}
+	return seqs[userID], nil
+}
+
+func (s *fileStore) GetHasReadSeqs(conversationID string) (map[string]uint32, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	seqs, err := s.get(conversationID)
+	if err != nil {
+		return nil, err
+	}
+	copied := make(map[string]uint32, len(seqs))
+	for k, v := range seqs {
+		copied[k] = v
+	}
+	return copied, nil
+}
@@ -0,0 +1,76 @@
+package receipt
+
+import (
+	"fmt"
+	"insight/pkg/common/config"
+	"sync"
+)
+
+const (
+	//已读回执存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// 已读回执存储，记录每个会话中每个成员已读到的seq
+// 会话id使用 utils.GetConversationIDBySessionType，为读者视角的会话id，单聊时和读者id一起才能确定会话
+// 单聊的seq为读者收件箱的seq，群聊的seq为群时间线的seq
+type Store interface {
+	//只会增大，比已记录的seq小时忽略
+	SetHasReadSeq(conversationID, userID string, seq uint32) error
+	//没有记录时为0
+	GetHasReadSeq(conversationID, userID string) (uint32, error)
+	//会话中所有成员的已读seq
+	GetHasReadSeqs(conversationID string) (map[string]uint32, error)
+}
+
+func NewStore(cfg config.Receipt) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return NewFileStore(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported receipt backend: %s", cfg.Backend)
+}
+
+// 内存已读回执存储，重启后数据会丢失，只适合测试使用
+type memoryStore struct {
+	rwLock *sync.RWMutex
+	seqs   map[string]map[string]uint32 //会话id -> 用户id -> 已读seq
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		rwLock: new(sync.RWMutex),
+		seqs:   make(map[string]map[string]uint32),
+	}
+}
+
+func (s *memoryStore) SetHasReadSeq(conversationID, userID string, seq uint32) error {
+	s.rwLock.Lock()
+	defer s.rwLock.Unlock()
+	if _, ok := s.seqs[conversationID]; !ok {
+		s.seqs[conversationID] = make(map[string]uint32)
+	}
+	if seq > s.seqs[conversationID][userID] {
+		s.seqs[conversationID][userID] = seq
+	}
+	return nil
+}
+
+func (s *memoryStore) GetHasReadSeq(conversationID, userID string) (uint32, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	return s.seqs[conversationID][userID], nil
+}
+
+func (s *memoryStore) GetHasReadSeqs(conversationID string) (map[string]uint32, error) {
+	s.rwLock.RLock()
+	defer s.rwLock.RUnlock()
+	seqs := make(map[string]uint32, len(s.seqs[conversationID]))
+	for userID, seq := range s.seqs[conversationID] {
+		seqs[userID] = seq
+	}
+	return seqs, nil
+}
@@ -0,0 +1,108 @@
+package seq
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 本地文件seq分配器，每个收件箱一个文件，内容为 "max min"
+// 内存中缓存已读取的seq，每次分配都会写回文件
+type fileAllocator struct {
+	dir    string
+	mutex  sync.Mutex
+	bounds map[string]*bound
+}
+
+func NewFileAllocator(dir string) (Allocator, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileAllocator{dir: dir, bounds: make(map[string]*bound)}, nil
+}
+
+// key做hex编码避免出现路径分隔符
+func (a *fileAllocator) file(key string) string {
+	return filepath.Join(a.dir, hex.EncodeToString([]byte(key)))
+}
+
+func (a *fileAllocator) get(key string) (*bound, error) {
+	if b, ok := a.bounds[key]; ok {
+		return b, nil
+	}
+	b := &bound{}
+	data, err := os.ReadFile(a.file(key))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if _, err := fmt.Sscanf(string(data), "%d %d", &b.max, &b.min); err != nil {
+			return nil, fmt.Errorf("parse seq file of %s: %w", key, err)
+		}
+	}
+	a.bounds[key] = b
+	return b, nil
+}
+
+// 先写临时文件再改名，避免写了一半时进程退出
+func (a *fileAllocator) save(key string, b *bound) error {
+	name := a.file(key)
+	if err := os.WriteFile(name+".tmp", []byte(fmt.Sprintf("%d %d", b.max, b.min)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (a *fileAllocator) Alloc(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	b, err := a.get(key)
+	if err != nil {
+		return 0, err
+	}
+	next := *b
+	seq := next.alloc()
+	if err := a.save(key, &next); err != nil {
+		return 0, err
+	}
+	*b = next
+	return seq, nil
+}
+
+func (a *fileAllocator) GetMaxSeq(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	b, err := a.get(key)
+	if err != nil {
+		return 0, err
+	}
+	return b.max, nil
+}
+
+func (a *fileAllocator) GetMinSeq(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	b, err := a.get(key)
+	if err != nil {
+		return 0, err
+	}
+	return b.min, nil
+}
+
+func (a *fileAllocator) SetMinSeq(key string, seq uint32) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	b, err := a.get(key)
+	if err != nil {
+		return err
+	}
+	next := *b
+	next.min = seq
+	if err := a.save(key, &next); err != nil {
+		return err
+	}
+	*b = next
+	return nil
+}
@@ -0,0 +1,50 @@
+package seq
+
+import "testing"
+
+func TestFileAllocatorReopen(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewFileAllocator(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := []string{"user/1", GroupKey("g1")}
+	for _, key := range keys {
+		for i := 0; i < 3; i++ {
+			if _, err := a.Alloc(key); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := a.SetMinSeq(keys[0], 2); err != nil {
+		t.Fatal(err)
+	}
+
+	//重新打开后seq从文件中恢复，继续递增
+	b, err := NewFileAllocator(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		key              string
+		wantMax, wantMin uint32
+		wantNext         uint32
+	}{
+		{keys[0], 3, 2, 4},
+		{keys[1], 3, 1, 4},
+		{"user/2", 0, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if max, err := b.GetMaxSeq(tt.key); err != nil || max != tt.wantMax {
+				t.Fatalf("max seq got %d %v, want %d", max, err, tt.wantMax)
+			}
+			if min, err := b.GetMinSeq(tt.key); err != nil || min != tt.wantMin {
+				t.Fatalf("min seq got %d %v, want %d", min, err, tt.wantMin)
+			}
+			if next, err := b.Alloc(tt.key); err != nil || next != tt.wantNext {
+				t.Fatalf("alloc got %d %v, want %d", next, err, tt.wantNext)
+			}
+		})
+	}
+}
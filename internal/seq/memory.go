@@ -0,0 +1,47 @@
+package seq
+
+import "sync"
+
+// 内存seq分配器，重启后seq会丢失，只适合测试使用
+type memoryAllocator struct {
+	mutex  sync.Mutex
+	bounds map[string]*bound
+}
+
+func NewMemoryAllocator() Allocator {
+	return &memoryAllocator{bounds: make(map[string]*bound)}
+}
+
+func (a *memoryAllocator) get(key string) *bound {
+	b, ok := a.bounds[key]
+	if !ok {
+		b = &bound{}
+		a.bounds[key] = b
+	}
+	return b
+}
+
+func (a *memoryAllocator) Alloc(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.get(key).alloc(), nil
+}
+
+func (a *memoryAllocator) GetMaxSeq(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.get(key).max, nil
+}
+
+func (a *memoryAllocator) GetMinSeq(key string) (uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.get(key).min, nil
+}
+
+func (a *memoryAllocator) SetMinSeq(key string, seq uint32) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.get(key).min = seq
+	return nil
+}
@@ -0,0 +1,62 @@
+package seq
+
+import (
+	"fmt"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"insight/pkg/utils"
+	"strings"
+)
+
+const (
+	//seq存储方式
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// seq 分配器
+// 每个收件箱(用户收件箱或群时间线)的seq独立单调递增，从1开始
+type Allocator interface {
+	//分配下一个seq
+	Alloc(key string) (uint32, error)
+	//已分配的最大seq，没有分配过时为0
+	GetMaxSeq(key string) (uint32, error)
+	//最小的有效seq，没有分配过时为0
+	GetMinSeq(key string) (uint32, error)
+	//设置最小的有效seq，清理历史消息后使用
+	SetMinSeq(key string, seq uint32) error
+}
+
+// 群时间线的key，与群会话id相同，用户收件箱直接使用用户id
+// 签发token时拒绝以群时间线前缀开头的用户id，避免两者的key冲突
+func GroupKey(groupID string) string {
+	return utils.GetConversationIDBySessionType(groupID, constant.GroupChatType)
+}
+
+func IsGroupKey(key string) bool {
+	return strings.HasPrefix(key, GroupKey(""))
+}
+
+func NewAllocator(cfg config.Seq) (Allocator, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		return NewMemoryAllocator(), nil
+	case BackendFile:
+		return NewFileAllocator(cfg.Dir)
+	}
+	return nil, fmt.Errorf("unsupported seq backend: %s", cfg.Backend)
+}
+
+// 一个收件箱的seq范围
+type bound struct {
+	max uint32
+	min uint32
+}
+
+func (b *bound) alloc() uint32 {
+	b.max++
+	if b.min == 0 {
+		b.min = 1
+	}
+	return b.max
+}
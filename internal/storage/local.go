@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	rpc "insight/pkg/proto/msg"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 本地文件存储，每个收件箱一个目录，每条消息一个文件，文件名为seq
+// 收件箱目录下的id目录存放服务端消息id到seq的索引
+// 只适合单机部署和测试使用
+type localStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+func NewLocalStore(dir string) (MessageStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &localStore{dir: dir}, nil
+}
+
+// 收件箱目录，key做hex编码避免出现路径分隔符
+func (s *localStore) keyDir(key string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(key)))
+}
+
+func (s *localStore) msgFile(key string, seq uint32) string {
+	return filepath.Join(s.keyDir(key), strconv.FormatUint(uint64(seq), 10))
+}
+
+func (s *localStore) idFile(key string, serverMsgID string) string {
+	return filepath.Join(s.keyDir(key), "id", hex.EncodeToString([]byte(serverMsgID)))
+}
+
+// 先写临时文件再改名，读取方不会读到写了一半的内容
+func writeFile(name string, b []byte) error {
+	if err := os.WriteFile(name+".tmp", b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (s *localStore) Append(key string, msg *rpc.MsgData) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := os.MkdirAll(filepath.Join(s.keyDir(key), "id"), 0755); err != nil {
+		return err
+	}
+	if err := writeFile(s.msgFile(key, msg.Seq), b); err != nil {
+		return err
+	}
+	if msg.ServerMsgID == "" {
+		return nil
+	}
+	return writeFile(s.idFile(key, msg.ServerMsgID), []byte(strconv.FormatUint(uint64(msg.Seq), 10)))
+}
+
+func (s *localStore) GetBySeqList(key string, seqs []uint32) ([]*rpc.MsgData, error) {
+	msgs := make([]*rpc.MsgData, 0, len(seqs))
+	for _, seq := range seqs {
+		b, err := os.ReadFile(s.msgFile(key, seq))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		msg := rpc.MsgData{}
+		if err := proto.Unmarshal(b, &msg); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &msg)
+	}
+	return msgs, nil
+}
+
+func (s *localStore) GetByServerMsgID(key string, serverMsgID string) (*rpc.MsgData, error) {
+	b, err := os.ReadFile(s.idFile(key, serverMsgID))
+	if os.IsNotExist(err) {
+		return nil, ErrMsgNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	seq, err := strconv.ParseUint(string(b), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := s.GetBySeqList(key, []uint32{uint32(seq)})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, ErrMsgNotExist
+	}
+	return msgs[0], nil
+}
+
+func (s *localStore) GetBySeqRange(key string, begin, end uint32) ([]*rpc.MsgData, error) {
+	return s.GetBySeqList(key, seqRange(begin, end))
+}
+
+func (s *localStore) Delete(key string, seqs []uint32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, seq := range seqs {
+		name := s.msgFile(key, seq)
+		b, err := os.ReadFile(name)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		msg := rpc.MsgData{}
+		if err := proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+		if msg.ServerMsgID == "" {
+			continue
+		}
+		if err := os.Remove(s.idFile(key, msg.ServerMsgID)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *localStore) MarkStatus(key string, seqs []uint32, status int32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, seq := range seqs {
+		name := s.msgFile(key, seq)
+		b, err := os.ReadFile(name)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		msg := rpc.MsgData{}
+		if err := proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+		msg.Status = status
+		if b, err = proto.Marshal(&msg); err != nil {
+			return err
+		}
+		if err := writeFile(name, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
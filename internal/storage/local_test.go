@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"reflect"
+	"testing"
+)
+
+const testKey = "user/1"
+
+// 往testKey中追加seq为1到n的消息，serverMsgID为"msg-"+seq
+func newTestStore(t *testing.T, n uint32) MessageStore {
+	t.Helper()
+	s, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for seq := uint32(1); seq <= n; seq++ {
+		msg := rpc.MsgData{Seq: seq, ServerMsgID: fmt.Sprintf("msg-%d", seq), Status: constant.MsgNormal}
+		if err := s.Append(testKey, &msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func seqsOf(msgs []*rpc.MsgData) []uint32 {
+	seqs := make([]uint32, 0, len(msgs))
+	for _, msg := range msgs {
+		seqs = append(seqs, msg.Seq)
+	}
+	return seqs
+}
+
+func TestLocalStoreAppend(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *rpc.MsgData
+	}{
+		{"new seq", &rpc.MsgData{Seq: 4, ServerMsgID: "msg-4", Content: []byte("new")}},
+		{"overwrite seq", &rpc.MsgData{Seq: 2, ServerMsgID: "msg-2", Content: []byte("overwrite")}},
+		{"without serverMsgID", &rpc.MsgData{Seq: 5, Content: []byte("no id")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStore(t, 3)
+			if err := s.Append(testKey, tt.msg); err != nil {
+				t.Fatal(err)
+			}
+			msgs, err := s.GetBySeqList(testKey, []uint32{tt.msg.Seq})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(msgs) != 1 || string(msgs[0].Content) != string(tt.msg.Content) {
+				t.Fatalf("got %v, want content %q", msgs, tt.msg.Content)
+			}
+		})
+	}
+}
+
+func TestLocalStoreGetBySeqRange(t *testing.T) {
+	s := newTestStore(t, 5)
+	tests := []struct {
+		name       string
+		key        string
+		begin, end uint32
+		want       []uint32
+	}{
+		{"all", testKey, 1, 5, []uint32{1, 2, 3, 4, 5}},
+		{"middle", testKey, 2, 4, []uint32{2, 3, 4}},
+		{"begin 0 as 1", testKey, 0, 2, []uint32{1, 2}},
+		{"beyond max seq", testKey, 4, 10, []uint32{4, 5}},
+		{"end before begin", testKey, 4, 3, []uint32{}},
+		{"unknown key", "user/2", 1, 5, []uint32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := s.GetBySeqRange(tt.key, tt.begin, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := seqsOf(msgs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalStoreGetBySeqList(t *testing.T) {
+	s := newTestStore(t, 5)
+	tests := []struct {
+		name string
+		seqs []uint32
+		want []uint32
+	}{
+		{"in order", []uint32{1, 3, 5}, []uint32{1, 3, 5}},
+		{"keep request order", []uint32{5, 2}, []uint32{5, 2}},
+		{"skip missing", []uint32{0, 2, 6}, []uint32{2}},
+		{"empty", nil, []uint32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := s.GetBySeqList(testKey, tt.seqs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := seqsOf(msgs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalStoreGetByServerMsgID(t *testing.T) {
+	s := newTestStore(t, 3)
+	if err := s.Delete(testKey, []uint32{3}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		key         string
+		serverMsgID string
+		wantSeq     uint32
+		wantErr     error
+	}{
+		{"exist", testKey, "msg-2", 2, nil},
+		{"deleted", testKey, "msg-3", 0, ErrMsgNotExist},
+		{"unknown id", testKey, "msg-9", 0, ErrMsgNotExist},
+		{"other key", "user/2", "msg-1", 0, ErrMsgNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := s.GetByServerMsgID(tt.key, tt.serverMsgID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got err %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && msg.Seq != tt.wantSeq {
+				t.Fatalf("got seq %d, want %d", msg.Seq, tt.wantSeq)
+			}
+		})
+	}
+}
+
+func TestLocalStoreMarkStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		seqs   []uint32
+		status int32
+		want   map[uint32]int32
+	}{
+		{"mark some", []uint32{1, 3}, constant.MsgDeleted, map[uint32]int32{1: constant.MsgDeleted, 2: constant.MsgNormal, 3: constant.MsgDeleted}},
+		{"skip missing", []uint32{2, 9}, constant.MsgRevoked, map[uint32]int32{1: constant.MsgNormal, 2: constant.MsgRevoked, 3: constant.MsgNormal}},
+		{"empty", nil, constant.MsgDeleted, map[uint32]int32{1: constant.MsgNormal, 2: constant.MsgNormal, 3: constant.MsgNormal}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStore(t, 3)
+			if err := s.MarkStatus(testKey, tt.seqs, tt.status); err != nil {
+				t.Fatal(err)
+			}
+			msgs, err := s.GetBySeqRange(testKey, 1, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[uint32]int32, len(msgs))
+			for _, msg := range msgs {
+				got[msg.Seq] = msg.Status
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+
+	rpc "insight/pkg/proto/msg"
+
+	_ "github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+)
+
+const createMsgTable = `CREATE TABLE IF NOT EXISTS chat_msg (
+	inbox_key VARCHAR(128) NOT NULL,
+	seq INT UNSIGNED NOT NULL,
+	server_msg_id VARCHAR(64) NOT NULL,
+	status INT NOT NULL,
+	send_time BIGINT NOT NULL,
+	data BLOB NOT NULL,
+	PRIMARY KEY (inbox_key, seq),
+	KEY idx_server_msg_id (inbox_key, server_msg_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`
+
+// mysql存储，消息体序列化后存放在data列，状态单独一列方便修改
+type mysqlStore struct {
+	db *sql.DB
+}
+
+func NewMySQLStore(dsn string) (MessageStore, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := db.Exec(createMsgTable); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &mysqlStore{db: db}, nil
+}
+
+// in查询的占位符和参数
+func seqArgs(key string, seqs []uint32) (string, []interface{}) {
+	args := make([]interface{}, 0, len(seqs)+1)
+	args = append(args, key)
+	for _, seq := range seqs {
+		args = append(args, seq)
+	}
+	return strings.TrimSuffix(strings.Repeat("?,", len(seqs)), ","), args
+}
+
+func (s *mysqlStore) Append(key string, msg *rpc.MsgData) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec("INSERT INTO chat_msg (inbox_key, seq, server_msg_id, status, send_time, data) VALUES (?, ?, ?, ?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE server_msg_id = VALUES(server_msg_id), status = VALUES(status), send_time = VALUES(send_time), data = VALUES(data)",
+		key, msg.Seq, msg.ServerMsgID, msg.Status, msg.SendTime, b)
+	return err
+}
+
+func (s *mysqlStore) query(query string, args ...interface{}) ([]*rpc.MsgData, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var msgs []*rpc.MsgData
+	for rows.Next() {
+		var status int32
+		var b []byte
+		if err := rows.Scan(&status, &b); err != nil {
+			return nil, err
+		}
+		msg := rpc.MsgData{}
+		if err := proto.Unmarshal(b, &msg); err != nil {
+			return nil, err
+		}
+		msg.Status = status
+		msgs = append(msgs, &msg)
+	}
+	return msgs, rows.Err()
+}
+
+func (s *mysqlStore) GetBySeqRange(key string, begin, end uint32) ([]*rpc.MsgData, error) {
+	seqs := seqRange(begin, end)
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	return s.query("SELECT status, data FROM chat_msg WHERE inbox_key = ? AND seq BETWEEN ? AND ? ORDER BY seq",
+		key, seqs[0], seqs[len(seqs)-1])
+}
+
+func (s *mysqlStore) GetByServerMsgID(key string, serverMsgID string) (*rpc.MsgData, error) {
+	msgs, err := s.query("SELECT status, data FROM chat_msg WHERE inbox_key = ? AND server_msg_id = ? LIMIT 1", key, serverMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, ErrMsgNotExist
+	}
+	return msgs[0], nil
+}
+
+func (s *mysqlStore) GetBySeqList(key string, seqs []uint32) ([]*rpc.MsgData, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	in, args := seqArgs(key, seqs)
+	return s.query("SELECT status, data FROM chat_msg WHERE inbox_key = ? AND seq IN ("+in+")", args...)
+}
+
+func (s *mysqlStore) Delete(key string, seqs []uint32) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	in, args := seqArgs(key, seqs)
+	_, err := s.db.Exec("DELETE FROM chat_msg WHERE inbox_key = ? AND seq IN ("+in+")", args...)
+	return err
+}
+
+func (s *mysqlStore) MarkStatus(key string, seqs []uint32, status int32) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	in, args := seqArgs(key, seqs)
+	_, err := s.db.Exec("UPDATE chat_msg SET status = ? WHERE inbox_key = ? AND seq IN ("+in+")", append([]interface{}{status}, args...)...)
+	return err
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"insight/pkg/utils"
+)
+
+const (
+	//消息存储方式
+	BackendLocal = "local"
+	BackendMySQL = "mysql"
+)
+
+var ErrMsgNotExist = errors.New("msg not exist")
+
+// 消息存储
+// 消息按收件箱存放，单聊收件箱的key为用户id，同一收件箱内用seq唯一标识一条消息
+type MessageStore interface {
+	//追加一条消息，msg.Seq 必须已经分配，同一seq重复追加时覆盖
+	Append(key string, msg *rpc.MsgData) error
+	//按seq范围获取消息，包含begin和end，按seq升序返回，不存在的seq会被忽略
+	GetBySeqRange(key string, begin, end uint32) ([]*rpc.MsgData, error)
+	//按seq列表获取消息，不存在的seq会被忽略
+	GetBySeqList(key string, seqs []uint32) ([]*rpc.MsgData, error)
+	//按服务端消息id获取收件箱中的消息，同一条消息在不同收件箱中的seq不同
+	GetByServerMsgID(key string, serverMsgID string) (*rpc.MsgData, error)
+	//删除消息，不存在的seq会被忽略
+	Delete(key string, seqs []uint32) error
+	//修改消息状态，如constant.MsgDeleted
+	MarkStatus(key string, seqs []uint32, status int32) error
+}
+
+func NewMessageStore(cfg config.Storage) (MessageStore, error) {
+	switch cfg.Backend {
+	case "", BackendLocal:
+		return NewLocalStore(cfg.Dir)
+	case BackendMySQL:
+		return NewMySQLStore(cfg.DSN)
+	}
+	return nil, fmt.Errorf("unsupported storage backend: %s", cfg.Backend)
+}
+
+// 单次按范围获取的最大条数，避免一次读出整个收件箱
+const maxRangeNum = 1000
+
+func seqRange(begin, end uint32) []uint32 {
+	if begin == 0 {
+		begin = 1
+	}
+	if end < begin {
+		return nil
+	}
+	if end-begin >= maxRangeNum {
+		end = begin + maxRangeNum - 1
+	}
+	seqs := make([]uint32, 0, end-begin+1)
+	for s := begin; s <= end; s++ {
+		seqs = append(seqs, s)
+	}
+	return seqs
+}
+
+// 消息是否需要写入存储，存历史或持久化的消息才分配seq并存储
+func NeedStore(msg *rpc.MsgData) bool {
+	return utils.GetSwitchFromOptions(msg.Options, constant.IsHistory) || utils.GetSwitchFromOptions(msg.Options, constant.IsPersistent)
+}
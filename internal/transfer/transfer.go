@@ -0,0 +1,187 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"insight/internal/kafka"
+	"insight/internal/push"
+	"insight/internal/seq"
+	"insight/internal/storage"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	rpc "insight/pkg/proto/msg"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// 消费消息服务投递到kafka的消息
+// 存入接收者的收件箱，再推送给持有接收者连接的网关，seq已由消息服务分配
+type MsgTransfer struct {
+	consumerGroup *kafka.MConsumerGroup
+	store         storage.MessageStore
+	gateClients   []rpc.GateClient
+	push          *push.Service
+	log           *zap.Logger
+}
+
+func NewMsgTransfer(cfg *config.TransferConfig, log *zap.Logger, pushService *push.Service) (*MsgTransfer, error) {
+	store, err := storage.NewMessageStore(cfg.StorageCfg)
+	if err != nil {
+		return nil, err
+	}
+	t := MsgTransfer{
+		consumerGroup: kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
+			KafkaVersion:   sarama.V2_0_0_0,
+			OffsetsInitial: sarama.OffsetNewest,
+			IsReturnErr:    false,
+		}, []string{cfg.KafkaCfg.Topic}, cfg.KafkaCfg.Addr, cfg.KafkaCfg.GroupID),
+		store: store,
+		push:  pushService,
+		log:   log,
+	}
+	//网关grpc客户端,后续用服务发现来替换
+	for _, addr := range cfg.RpcCfg.GateAddrs {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			return nil, err
+		}
+		t.gateClients = append(t.gateClients, rpc.NewGateClient(conn))
+	}
+	return &t, nil
+}
+
+func (t *MsgTransfer) Start() {
+	t.consumerGroup.RegisterHandleAndConsumer(t)
+}
+
+func (t *MsgTransfer) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (t *MsgTransfer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+const (
+	//存储失败时重试的首次间隔，之后每次翻倍
+	retryInterval = 500 * time.Millisecond
+	//存储失败时重试的最大间隔
+	maxRetryInterval = 30 * time.Second
+)
+
+func (t *MsgTransfer) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		//存储失败时按退避重试，不能提交offset，否则消息会丢失
+		//会话结束(如rebalance)时直接返回，未提交的消息会重新投递
+		interval := retryInterval
+		for {
+			err := t.handleMsg(string(msg.Key), msg.Value)
+			if err == nil {
+				break
+			}
+			t.log.Error("handle msg failed, retry later", zap.String("key", string(msg.Key)), zap.Int64("offset", msg.Offset), zap.Duration("interval", interval), zap.String("err", err.Error()))
+			select {
+			case <-sess.Context().Done():
+				return nil
+			case <-time.After(interval):
+			}
+			if interval *= 2; interval > maxRetryInterval {
+				interval = maxRetryInterval
+			}
+		}
+		sess.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+// 处理一条消息，kafka消息的key为接收者的用户id或群时间线的key
+// 只有存储失败时返回错误，格式错误的消息重试也无法处理，记录日志后跳过
+func (t *MsgTransfer) handleMsg(userID string, value []byte) error {
+	req := rpc.SendMsgReq{}
+	if err := proto.Unmarshal(value, &req); err != nil || req.Data == nil {
+		t.log.Error("unmarshal kafka msg failed", zap.String("userID", userID), zap.Error(err))
+		return nil
+	}
+	//需要存历史或持久化的消息才写入存储，否则只推送在线用户
+	if storage.NeedStore(req.Data) {
+		if req.Data.Seq == 0 {
+			t.log.Error("msg seq not allocated", zap.String("operationID", req.OperationID), zap.String("userID", userID))
+			return nil
+		}
+		if err := t.store.Append(userID, req.Data); err != nil {
+			t.log.Error("store msg failed", zap.String("operationID", req.OperationID), zap.String("userID", userID), zap.String("err", err.Error()))
+			return err
+		}
+	}
+	//撤回消息投递到每个收件箱时，修改该收件箱中被撤回消息的状态
+	if req.Data.ContentType == constant.Revoke {
+		if err := t.revokeMsg(req.OperationID, userID, req.Data); err != nil {
+			return err
+		}
+	}
+	//群时间线只存储，成员通过各自的收件箱收到推送
+	if seq.IsGroupKey(userID) {
+		return nil
+	}
+	//没有在线连接时离线推送
+	if !t.pushToGate(req.OperationID, userID, req.Data) {
+		t.push.Push(req.OperationID, userID, req.Data)
+	}
+	return nil
+}
+
+// 修改状态失败时返回错误，由调用方重试
+func (t *MsgTransfer) revokeMsg(operationID, key string, data *rpc.MsgData) error {
+	content := rpc.RevokeContent{}
+	if err := proto.Unmarshal(data.Content, &content); err != nil {
+		t.log.Error("unmarshal revoke content failed", zap.String("operationID", operationID), zap.String("key", key), zap.String("err", err.Error()))
+		return nil
+	}
+	target, err := t.store.GetByServerMsgID(key, content.ServerMsgID)
+	if err != nil && !errors.Is(err, storage.ErrMsgNotExist) {
+		t.log.Error("get revoke msg failed", zap.String("operationID", operationID), zap.String("key", key), zap.String("serverMsgID", content.ServerMsgID), zap.String("err", err.Error()))
+		return err
+	}
+	if err != nil {
+		//发送者未同步等情况下收件箱中没有这条消息
+		t.log.Info("revoke msg not in inbox", zap.String("operationID", operationID), zap.String("key", key), zap.String("serverMsgID", content.ServerMsgID), zap.String("err", err.Error()))
+		return nil
+	}
+	if err := t.store.MarkStatus(key, []uint32{target.Seq}, constant.MsgRevoked); err != nil {
+		t.log.Error("mark msg revoked failed", zap.String("operationID", operationID), zap.String("key", key), zap.String("serverMsgID", content.ServerMsgID), zap.String("err", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// 推送给所有网关，只有持有接收者连接的网关会真正下发
+// 返回是否有连接推送成功
+func (t *MsgTransfer) pushToGate(operationID, userID string, data *rpc.MsgData) bool {
+	online := false
+	for _, client := range t.gateClients {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		resp, err := client.PushMsgToUser(ctx, &rpc.PushMsgToUserReq{
+			OperationID: operationID,
+			UserID:      userID,
+			MsgData:     data,
+		})
+		cancel()
+		if err != nil {
+			t.log.Error("push msg to gate failed", zap.String("operationID", operationID), zap.String("userID", userID), zap.String("err", err.Error()))
+			continue
+		}
+		if len(resp.Results) != 0 {
+			t.log.Info("push msg to gate success", zap.String("operationID", operationID), zap.String("userID", userID), zap.Uint32("seq", data.Seq), zap.Int("conns", len(resp.Results)))
+		}
+		for _, result := range resp.Results {
+			if result.ResultCode == 0 {
+				online = true
+			}
+		}
+	}
+	return online
+}
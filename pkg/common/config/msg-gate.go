@@ -3,10 +3,24 @@ package config
 type GateConfig struct {
 	TcpSvrCfg TcpSvr `toml:"tcp_svr"`
 	WsSvrCfg  WsSvr  `toml:"ws_svr"`
+	TokenCfg  Token  `toml:"token"`
+	RpcCfg    Rpc    `toml:"rpc"`
+	LoginCfg  Login  `toml:"login"`
+}
+
+type Login struct {
+	MultiTerminalPolicy int `toml:"multi_terminal_policy"`
+}
+
+type Rpc struct {
+	Port    string
+	MsgAddr string `toml:"msg_addr"`
 }
 
 type TcpSvr struct {
-	Port string
+	Port      string
+	MaxMsgLen int `toml:"max_msg_len"`
+	Timeout   int `toml:"timeout"`
 }
 
 type WsSvr struct {
@@ -0,0 +1,53 @@
+package config
+
+type MsgConfig struct {
+	RpcCfg          MsgRpc       `toml:"rpc"`
+	TokenCfg        Token        `toml:"token"`
+	KickCfg         Kick         `toml:"kick"`
+	SeqCfg          Seq          `toml:"seq"`
+	StorageCfg      Storage      `toml:"storage"`
+	RevokeCfg       Revoke       `toml:"revoke"`
+	GroupCfg        Group        `toml:"group"`
+	FriendCfg       Friend       `toml:"friend"`
+	ReceiptCfg      Receipt      `toml:"receipt"`
+	ConversationCfg Conversation `toml:"conversation"`
+}
+
+type Revoke struct {
+	Window int64 `toml:"window"` //可撤回的时间窗口，单位秒
+}
+
+type Seq struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type Kick struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type Group struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type Friend struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type Receipt struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type Conversation struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+}
+
+type MsgRpc struct {
+	Port      string
+	GateAddrs []string `toml:"gate_addrs"`
+}
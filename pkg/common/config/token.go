@@ -0,0 +1,11 @@
+package config
+
+// token 签名配置
+// algorithm 支持 HS256/HS384/HS512(使用secret) 与 RS256/RS384/RS512(使用RSA密钥文件)
+type Token struct {
+	Algorithm      string `toml:"algorithm"`
+	Secret         string `toml:"secret"`
+	PublicKeyFile  string `toml:"public_key_file"`
+	PrivateKeyFile string `toml:"private_key_file"` //签发方使用
+	Expire         int64  `toml:"expire"`           //有效期,单位秒,签发方使用
+}
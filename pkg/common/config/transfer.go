@@ -0,0 +1,30 @@
+package config
+
+type TransferConfig struct {
+	KafkaCfg   Kafka       `toml:"kafka"`
+	RpcCfg     TransferRpc `toml:"rpc"`
+	StorageCfg Storage     `toml:"storage"`
+	PushCfg    Push        `toml:"push"`
+}
+
+type Push struct {
+	Provider string `toml:"provider"`
+	File     string `toml:"file"`
+	URL      string `toml:"url"`
+}
+
+type Kafka struct {
+	Addr    []string `toml:"addr"`
+	Topic   string   `toml:"topic"`
+	GroupID string   `toml:"group_id"`
+}
+
+type TransferRpc struct {
+	GateAddrs []string `toml:"gate_addrs"`
+}
+
+type Storage struct {
+	Backend string `toml:"backend"`
+	Dir     string `toml:"dir"`
+	DSN     string `toml:"dsn"`
+}
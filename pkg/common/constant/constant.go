@@ -14,6 +14,7 @@ const (
 	WSSendMsg          = 1003
 	WSHeartbeat        = 1004
 	WSSendSignalMsg    = 1005
+	WSGetMsgReadStatus = 1006
 	WSPushMsg          = 2001
 	WSKickOnlineMsg    = 2002
 	WsLogoutMsg        = 2003
@@ -92,6 +93,7 @@ const (
 	//status
 	MsgNormal  = 1
 	MsgDeleted = 4
+	MsgRevoked = 5
 
 	//MsgFrom
 	UserMsgType = 100
@@ -137,6 +139,11 @@ const (
 	IsSenderSync               = "senderSync"
 	IsNotPrivate               = "notPrivate"
 	IsSenderConversationUpdate = "senderConversationUpdate"
+	//被@的用户收到的消息，会话免打扰时也强制推送和提醒
+	IsForceNotify = "forceNotify"
+
+	//@所有人
+	AtAllString = "AtAllTag"
 
 	//GroupStatus
 	GroupOk              = 0
@@ -147,6 +154,11 @@ const (
 	GroupBaned          = 3
 	GroupBanPrivateChat = 4
 
+	//GroupMemberRole
+	GroupOrdinaryUsers = 1
+	GroupAdmin         = 2
+	GroupOwner         = 3
+
 	//UserJoinGroupSource
 	JoinByAdmin = 1
 
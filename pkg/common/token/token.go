@@ -0,0 +1,173 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"insight/pkg/common/config"
+	"insight/pkg/common/constant"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	ErrTokenInvalid = errors.New("token invalid")
+	ErrTokenExpired = errors.New("token expired")
+	ErrTokenKicked  = errors.New("token kicked")
+)
+
+// token 载荷，绑定用户和登录平台
+type Claims struct {
+	UID        string `json:"uid"`
+	PlatformID int32  `json:"platformID"`
+	jwt.RegisteredClaims
+}
+
+// token 校验器，网关鉴权时使用，可替换为其他实现
+type Verifier interface {
+	Verify(tokenString string) (*Claims, error)
+}
+
+// token 签发器
+type Signer interface {
+	Sign(uid string, platformID int32) (tokenString string, expireTime int64, err error)
+}
+
+// 把校验错误映射为 constant 中定义的token状态
+func State(err error) int32 {
+	switch {
+	case err == nil:
+		return constant.NormalToken
+	case errors.Is(err, ErrTokenExpired):
+		return constant.ExpiredToken
+	case errors.Is(err, ErrTokenKicked):
+		return constant.KickedToken
+	default:
+		return constant.InValidToken
+	}
+}
+
+// 把token状态映射为校验错误，正常状态返回nil
+func StateError(state int32) error {
+	switch state {
+	case constant.NormalToken:
+		return nil
+	case constant.ExpiredToken:
+		return ErrTokenExpired
+	case constant.KickedToken:
+		return ErrTokenKicked
+	default:
+		return ErrTokenInvalid
+	}
+}
+
+// 基于jwt签名的token校验器
+type jwtVerifier struct {
+	method jwt.SigningMethod
+	key    interface{}
+}
+
+func NewVerifier(cfg config.Token) (Verifier, error) {
+	method := jwt.GetSigningMethod(cfg.Algorithm)
+	if method == nil {
+		return nil, fmt.Errorf("unsupported token algorithm: %s", cfg.Algorithm)
+	}
+	v := &jwtVerifier{method: method}
+	switch {
+	case strings.HasPrefix(cfg.Algorithm, "HS"):
+		if cfg.Secret == "" {
+			return nil, errors.New("token secret is empty")
+		}
+		v.key = []byte(cfg.Secret)
+	case strings.HasPrefix(cfg.Algorithm, "RS"):
+		pem, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if v.key, err = jwt.ParseRSAPublicKeyFromPEM(pem); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported token algorithm: %s", cfg.Algorithm)
+	}
+	return v, nil
+}
+
+func (v *jwtVerifier) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	t, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		//只接受配置的签名算法，防止算法替换攻击
+		if t.Method.Alg() != v.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return v.key, nil
+	})
+	if err != nil {
+		var ve *jwt.ValidationError
+		if errors.As(err, &ve) && ve.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, fmt.Errorf("%w: %s", ErrTokenExpired, err.Error())
+		}
+		return nil, fmt.Errorf("%w: %s", ErrTokenInvalid, err.Error())
+	}
+	if !t.Valid || claims.UID == "" || claims.ExpiresAt == nil {
+		return nil, ErrTokenInvalid
+	}
+	return claims, nil
+}
+
+// 基于jwt签名的token签发器
+type jwtSigner struct {
+	method jwt.SigningMethod
+	key    interface{}
+	expire time.Duration
+}
+
+func NewSigner(cfg config.Token) (Signer, error) {
+	method := jwt.GetSigningMethod(cfg.Algorithm)
+	if method == nil {
+		return nil, fmt.Errorf("unsupported token algorithm: %s", cfg.Algorithm)
+	}
+	if cfg.Expire <= 0 {
+		return nil, errors.New("token expire must be positive")
+	}
+	s := &jwtSigner{method: method, expire: time.Duration(cfg.Expire) * time.Second}
+	switch {
+	case strings.HasPrefix(cfg.Algorithm, "HS"):
+		if cfg.Secret == "" {
+			return nil, errors.New("token secret is empty")
+		}
+		s.key = []byte(cfg.Secret)
+	case strings.HasPrefix(cfg.Algorithm, "RS"):
+		pem, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if s.key, err = jwt.ParseRSAPrivateKeyFromPEM(pem); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported token algorithm: %s", cfg.Algorithm)
+	}
+	return s, nil
+}
+
+func (s *jwtSigner) Sign(uid string, platformID int32) (string, int64, error) {
+	now := time.Now()
+	expireAt := now.Add(s.expire)
+	claims := Claims{
+		UID:        uid,
+		PlatformID: platformID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expireAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+	tokenString, err := jwt.NewWithClaims(s.method, claims).SignedString(s.key)
+	if err != nil {
+		return "", 0, err
+	}
+	return tokenString, expireAt.Unix(), nil
+}
@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type ChatClient interface {
 	SendMsg(ctx context.Context, in *SendMsgReq, opts ...grpc.CallOption) (*SendMsgResp, error)
 	GetMaxAndMinSeq(ctx context.Context, in *GetMaxAndMinSeqReq, opts ...grpc.CallOption) (*GetMaxAndMinSeqResp, error)
+	PullMessageBySeqList(ctx context.Context, in *PullMessageBySeqListReq, opts ...grpc.CallOption) (*PullMessageBySeqListResp, error)
+	GetMsgReadStatus(ctx context.Context, in *GetMsgReadStatusReq, opts ...grpc.CallOption) (*GetMsgReadStatusResp, error)
 }
 
 type chatClient struct {
@@ -52,12 +54,32 @@ func (c *chatClient) GetMaxAndMinSeq(ctx context.Context, in *GetMaxAndMinSeqReq
 	return out, nil
 }
 
+func (c *chatClient) PullMessageBySeqList(ctx context.Context, in *PullMessageBySeqListReq, opts ...grpc.CallOption) (*PullMessageBySeqListResp, error) {
+	out := new(PullMessageBySeqListResp)
+	err := c.cc.Invoke(ctx, "/proto.Chat/PullMessageBySeqList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetMsgReadStatus(ctx context.Context, in *GetMsgReadStatusReq, opts ...grpc.CallOption) (*GetMsgReadStatusResp, error) {
+	out := new(GetMsgReadStatusResp)
+	err := c.cc.Invoke(ctx, "/proto.Chat/GetMsgReadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServer is the server API for Chat service.
 // All implementations must embed UnimplementedChatServer
 // for forward compatibility
 type ChatServer interface {
 	SendMsg(context.Context, *SendMsgReq) (*SendMsgResp, error)
 	GetMaxAndMinSeq(context.Context, *GetMaxAndMinSeqReq) (*GetMaxAndMinSeqResp, error)
+	PullMessageBySeqList(context.Context, *PullMessageBySeqListReq) (*PullMessageBySeqListResp, error)
+	GetMsgReadStatus(context.Context, *GetMsgReadStatusReq) (*GetMsgReadStatusResp, error)
 	mustEmbedUnimplementedChatServer()
 }
 
@@ -71,6 +93,12 @@ func (UnimplementedChatServer) SendMsg(context.Context, *SendMsgReq) (*SendMsgRe
 func (UnimplementedChatServer) GetMaxAndMinSeq(context.Context, *GetMaxAndMinSeqReq) (*GetMaxAndMinSeqResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaxAndMinSeq not implemented")
 }
+func (UnimplementedChatServer) PullMessageBySeqList(context.Context, *PullMessageBySeqListReq) (*PullMessageBySeqListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullMessageBySeqList not implemented")
+}
+func (UnimplementedChatServer) GetMsgReadStatus(context.Context, *GetMsgReadStatusReq) (*GetMsgReadStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgReadStatus not implemented")
+}
 func (UnimplementedChatServer) mustEmbedUnimplementedChatServer() {}
 
 // UnsafeChatServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +148,42 @@ func _Chat_GetMaxAndMinSeq_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_PullMessageBySeqList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullMessageBySeqListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).PullMessageBySeqList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/PullMessageBySeqList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).PullMessageBySeqList(ctx, req.(*PullMessageBySeqListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetMsgReadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgReadStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetMsgReadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/GetMsgReadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetMsgReadStatus(ctx, req.(*GetMsgReadStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +199,14 @@ var Chat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMaxAndMinSeq",
 			Handler:    _Chat_GetMaxAndMinSeq_Handler,
 		},
+		{
+			MethodName: "PullMessageBySeqList",
+			Handler:    _Chat_PullMessageBySeqList_Handler,
+		},
+		{
+			MethodName: "GetMsgReadStatus",
+			Handler:    _Chat_GetMsgReadStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat.proto",
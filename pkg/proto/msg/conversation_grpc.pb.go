@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: conversation.proto
+
+package msg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ConversationClient is the client API for Conversation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationClient interface {
+	SetReceiveMessageOpt(ctx context.Context, in *SetReceiveMessageOptReq, opts ...grpc.CallOption) (*SetReceiveMessageOptResp, error)
+	GetReceiveMessageOpt(ctx context.Context, in *GetReceiveMessageOptReq, opts ...grpc.CallOption) (*GetReceiveMessageOptResp, error)
+}
+
+type conversationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationClient(cc grpc.ClientConnInterface) ConversationClient {
+	return &conversationClient{cc}
+}
+
+func (c *conversationClient) SetReceiveMessageOpt(ctx context.Context, in *SetReceiveMessageOptReq, opts ...grpc.CallOption) (*SetReceiveMessageOptResp, error) {
+	out := new(SetReceiveMessageOptResp)
+	err := c.cc.Invoke(ctx, "/proto.Conversation/SetReceiveMessageOpt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetReceiveMessageOpt(ctx context.Context, in *GetReceiveMessageOptReq, opts ...grpc.CallOption) (*GetReceiveMessageOptResp, error) {
+	out := new(GetReceiveMessageOptResp)
+	err := c.cc.Invoke(ctx, "/proto.Conversation/GetReceiveMessageOpt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationServer is the server API for Conversation service.
+// All implementations must embed UnimplementedConversationServer
+// for forward compatibility
+type ConversationServer interface {
+	SetReceiveMessageOpt(context.Context, *SetReceiveMessageOptReq) (*SetReceiveMessageOptResp, error)
+	GetReceiveMessageOpt(context.Context, *GetReceiveMessageOptReq) (*GetReceiveMessageOptResp, error)
+	mustEmbedUnimplementedConversationServer()
+}
+
+// UnimplementedConversationServer must be embedded to have forward compatible implementations.
+type UnimplementedConversationServer struct {
+}
+
+func (UnimplementedConversationServer) SetReceiveMessageOpt(context.Context, *SetReceiveMessageOptReq) (*SetReceiveMessageOptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiveMessageOpt not implemented")
+}
+func (UnimplementedConversationServer) GetReceiveMessageOpt(context.Context, *GetReceiveMessageOptReq) (*GetReceiveMessageOptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiveMessageOpt not implemented")
+}
+func (UnimplementedConversationServer) mustEmbedUnimplementedConversationServer() {}
+
+// UnsafeConversationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationServer will
+// result in compilation errors.
+type UnsafeConversationServer interface {
+	mustEmbedUnimplementedConversationServer()
+}
+
+func RegisterConversationServer(s grpc.ServiceRegistrar, srv ConversationServer) {
+	s.RegisterService(&Conversation_ServiceDesc, srv)
+}
+
+func _Conversation_SetReceiveMessageOpt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReceiveMessageOptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).SetReceiveMessageOpt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Conversation/SetReceiveMessageOpt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).SetReceiveMessageOpt(ctx, req.(*SetReceiveMessageOptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetReceiveMessageOpt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiveMessageOptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetReceiveMessageOpt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Conversation/GetReceiveMessageOpt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetReceiveMessageOpt(ctx, req.(*GetReceiveMessageOptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Conversation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Conversation",
+	HandlerType: (*ConversationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetReceiveMessageOpt",
+			Handler:    _Conversation_SetReceiveMessageOpt_Handler,
+		},
+		{
+			MethodName: "GetReceiveMessageOpt",
+			Handler:    _Conversation_GetReceiveMessageOpt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "conversation.proto",
+}
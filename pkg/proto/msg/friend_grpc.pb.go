@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: friend.proto
+
+package msg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FriendClient is the client API for Friend service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FriendClient interface {
+	AddFriend(ctx context.Context, in *AddFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	AddFriendResponse(ctx context.Context, in *AddFriendResponseReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	DeleteFriend(ctx context.Context, in *DeleteFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	SetFriendRemark(ctx context.Context, in *SetFriendRemarkReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	GetFriendList(ctx context.Context, in *GetFriendListReq, opts ...grpc.CallOption) (*GetFriendListResp, error)
+	GetFriendApplicationList(ctx context.Context, in *GetFriendApplicationListReq, opts ...grpc.CallOption) (*GetFriendApplicationListResp, error)
+	AddBlack(ctx context.Context, in *AddBlackReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	RemoveBlack(ctx context.Context, in *RemoveBlackReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+	GetBlackList(ctx context.Context, in *GetBlackListReq, opts ...grpc.CallOption) (*GetBlackListResp, error)
+	CheckRelation(ctx context.Context, in *CheckRelationReq, opts ...grpc.CallOption) (*CheckRelationResp, error)
+	SetRequireFriend(ctx context.Context, in *SetRequireFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error)
+}
+
+type friendClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFriendClient(cc grpc.ClientConnInterface) FriendClient {
+	return &friendClient{cc}
+}
+
+func (c *friendClient) AddFriend(ctx context.Context, in *AddFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/AddFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) AddFriendResponse(ctx context.Context, in *AddFriendResponseReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/AddFriendResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) DeleteFriend(ctx context.Context, in *DeleteFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/DeleteFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetFriendRemark(ctx context.Context, in *SetFriendRemarkReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/SetFriendRemark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendList(ctx context.Context, in *GetFriendListReq, opts ...grpc.CallOption) (*GetFriendListResp, error) {
+	out := new(GetFriendListResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/GetFriendList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendApplicationList(ctx context.Context, in *GetFriendApplicationListReq, opts ...grpc.CallOption) (*GetFriendApplicationListResp, error) {
+	out := new(GetFriendApplicationListResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/GetFriendApplicationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) AddBlack(ctx context.Context, in *AddBlackReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/AddBlack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) RemoveBlack(ctx context.Context, in *RemoveBlackReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/RemoveBlack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetBlackList(ctx context.Context, in *GetBlackListReq, opts ...grpc.CallOption) (*GetBlackListResp, error) {
+	out := new(GetBlackListResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/GetBlackList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) CheckRelation(ctx context.Context, in *CheckRelationReq, opts ...grpc.CallOption) (*CheckRelationResp, error) {
+	out := new(CheckRelationResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/CheckRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetRequireFriend(ctx context.Context, in *SetRequireFriendReq, opts ...grpc.CallOption) (*FriendCommonResp, error) {
+	out := new(FriendCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Friend/SetRequireFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FriendServer is the server API for Friend service.
+// All implementations must embed UnimplementedFriendServer
+// for forward compatibility
+type FriendServer interface {
+	AddFriend(context.Context, *AddFriendReq) (*FriendCommonResp, error)
+	AddFriendResponse(context.Context, *AddFriendResponseReq) (*FriendCommonResp, error)
+	DeleteFriend(context.Context, *DeleteFriendReq) (*FriendCommonResp, error)
+	SetFriendRemark(context.Context, *SetFriendRemarkReq) (*FriendCommonResp, error)
+	GetFriendList(context.Context, *GetFriendListReq) (*GetFriendListResp, error)
+	GetFriendApplicationList(context.Context, *GetFriendApplicationListReq) (*GetFriendApplicationListResp, error)
+	AddBlack(context.Context, *AddBlackReq) (*FriendCommonResp, error)
+	RemoveBlack(context.Context, *RemoveBlackReq) (*FriendCommonResp, error)
+	GetBlackList(context.Context, *GetBlackListReq) (*GetBlackListResp, error)
+	CheckRelation(context.Context, *CheckRelationReq) (*CheckRelationResp, error)
+	SetRequireFriend(context.Context, *SetRequireFriendReq) (*FriendCommonResp, error)
+	mustEmbedUnimplementedFriendServer()
+}
+
+// UnimplementedFriendServer must be embedded to have forward compatible implementations.
+type UnimplementedFriendServer struct {
+}
+
+func (UnimplementedFriendServer) AddFriend(context.Context, *AddFriendReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFriend not implemented")
+}
+func (UnimplementedFriendServer) AddFriendResponse(context.Context, *AddFriendResponseReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFriendResponse not implemented")
+}
+func (UnimplementedFriendServer) DeleteFriend(context.Context, *DeleteFriendReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriend not implemented")
+}
+func (UnimplementedFriendServer) SetFriendRemark(context.Context, *SetFriendRemarkReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendRemark not implemented")
+}
+func (UnimplementedFriendServer) GetFriendList(context.Context, *GetFriendListReq) (*GetFriendListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendList not implemented")
+}
+func (UnimplementedFriendServer) GetFriendApplicationList(context.Context, *GetFriendApplicationListReq) (*GetFriendApplicationListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendApplicationList not implemented")
+}
+func (UnimplementedFriendServer) AddBlack(context.Context, *AddBlackReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlack not implemented")
+}
+func (UnimplementedFriendServer) RemoveBlack(context.Context, *RemoveBlackReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlack not implemented")
+}
+func (UnimplementedFriendServer) GetBlackList(context.Context, *GetBlackListReq) (*GetBlackListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlackList not implemented")
+}
+func (UnimplementedFriendServer) CheckRelation(context.Context, *CheckRelationReq) (*CheckRelationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRelation not implemented")
+}
+func (UnimplementedFriendServer) SetRequireFriend(context.Context, *SetRequireFriendReq) (*FriendCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequireFriend not implemented")
+}
+func (UnimplementedFriendServer) mustEmbedUnimplementedFriendServer() {}
+
+// UnsafeFriendServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FriendServer will
+// result in compilation errors.
+type UnsafeFriendServer interface {
+	mustEmbedUnimplementedFriendServer()
+}
+
+func RegisterFriendServer(s grpc.ServiceRegistrar, srv FriendServer) {
+	s.RegisterService(&Friend_ServiceDesc, srv)
+}
+
+func _Friend_AddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).AddFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/AddFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).AddFriend(ctx, req.(*AddFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_AddFriendResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendResponseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).AddFriendResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/AddFriendResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).AddFriendResponse(ctx, req.(*AddFriendResponseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).DeleteFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/DeleteFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).DeleteFriend(ctx, req.(*DeleteFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetFriendRemark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendRemarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendRemark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/SetFriendRemark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendRemark(ctx, req.(*SetFriendRemarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/GetFriendList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendList(ctx, req.(*GetFriendListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendApplicationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendApplicationListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendApplicationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/GetFriendApplicationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendApplicationList(ctx, req.(*GetFriendApplicationListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_AddBlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).AddBlack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/AddBlack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).AddBlack(ctx, req.(*AddBlackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_RemoveBlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBlackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).RemoveBlack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/RemoveBlack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).RemoveBlack(ctx, req.(*RemoveBlackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetBlackList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlackListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetBlackList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/GetBlackList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetBlackList(ctx, req.(*GetBlackListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_CheckRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).CheckRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/CheckRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).CheckRelation(ctx, req.(*CheckRelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetRequireFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequireFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetRequireFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Friend/SetRequireFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetRequireFriend(ctx, req.(*SetRequireFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Friend_ServiceDesc is the grpc.ServiceDesc for Friend service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Friend_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Friend",
+	HandlerType: (*FriendServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddFriend",
+			Handler:    _Friend_AddFriend_Handler,
+		},
+		{
+			MethodName: "AddFriendResponse",
+			Handler:    _Friend_AddFriendResponse_Handler,
+		},
+		{
+			MethodName: "DeleteFriend",
+			Handler:    _Friend_DeleteFriend_Handler,
+		},
+		{
+			MethodName: "SetFriendRemark",
+			Handler:    _Friend_SetFriendRemark_Handler,
+		},
+		{
+			MethodName: "GetFriendList",
+			Handler:    _Friend_GetFriendList_Handler,
+		},
+		{
+			MethodName: "GetFriendApplicationList",
+			Handler:    _Friend_GetFriendApplicationList_Handler,
+		},
+		{
+			MethodName: "AddBlack",
+			Handler:    _Friend_AddBlack_Handler,
+		},
+		{
+			MethodName: "RemoveBlack",
+			Handler:    _Friend_RemoveBlack_Handler,
+		},
+		{
+			MethodName: "GetBlackList",
+			Handler:    _Friend_GetBlackList_Handler,
+		},
+		{
+			MethodName: "CheckRelation",
+			Handler:    _Friend_CheckRelation_Handler,
+		},
+		{
+			MethodName: "SetRequireFriend",
+			Handler:    _Friend_SetRequireFriend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "friend.proto",
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: gate.proto
+
+package msg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GateClient is the client API for Gate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GateClient interface {
+	PushMsgToUser(ctx context.Context, in *PushMsgToUserReq, opts ...grpc.CallOption) (*PushMsgToUserResp, error)
+	KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserResp, error)
+	GetUsersOnlineStatus(ctx context.Context, in *GetUsersOnlineStatusReq, opts ...grpc.CallOption) (*GetUsersOnlineStatusResp, error)
+}
+
+type gateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGateClient(cc grpc.ClientConnInterface) GateClient {
+	return &gateClient{cc}
+}
+
+func (c *gateClient) PushMsgToUser(ctx context.Context, in *PushMsgToUserReq, opts ...grpc.CallOption) (*PushMsgToUserResp, error) {
+	out := new(PushMsgToUserResp)
+	err := c.cc.Invoke(ctx, "/proto.Gate/PushMsgToUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gateClient) KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserResp, error) {
+	out := new(KickUserResp)
+	err := c.cc.Invoke(ctx, "/proto.Gate/KickUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gateClient) GetUsersOnlineStatus(ctx context.Context, in *GetUsersOnlineStatusReq, opts ...grpc.CallOption) (*GetUsersOnlineStatusResp, error) {
+	out := new(GetUsersOnlineStatusResp)
+	err := c.cc.Invoke(ctx, "/proto.Gate/GetUsersOnlineStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GateServer is the server API for Gate service.
+// All implementations must embed UnimplementedGateServer
+// for forward compatibility
+type GateServer interface {
+	PushMsgToUser(context.Context, *PushMsgToUserReq) (*PushMsgToUserResp, error)
+	KickUser(context.Context, *KickUserReq) (*KickUserResp, error)
+	GetUsersOnlineStatus(context.Context, *GetUsersOnlineStatusReq) (*GetUsersOnlineStatusResp, error)
+	mustEmbedUnimplementedGateServer()
+}
+
+// UnimplementedGateServer must be embedded to have forward compatible implementations.
+type UnimplementedGateServer struct {
+}
+
+func (UnimplementedGateServer) PushMsgToUser(context.Context, *PushMsgToUserReq) (*PushMsgToUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushMsgToUser not implemented")
+}
+func (UnimplementedGateServer) KickUser(context.Context, *KickUserReq) (*KickUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
+func (UnimplementedGateServer) GetUsersOnlineStatus(context.Context, *GetUsersOnlineStatusReq) (*GetUsersOnlineStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersOnlineStatus not implemented")
+}
+func (UnimplementedGateServer) mustEmbedUnimplementedGateServer() {}
+
+// UnsafeGateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GateServer will
+// result in compilation errors.
+type UnsafeGateServer interface {
+	mustEmbedUnimplementedGateServer()
+}
+
+func RegisterGateServer(s grpc.ServiceRegistrar, srv GateServer) {
+	s.RegisterService(&Gate_ServiceDesc, srv)
+}
+
+func _Gate_PushMsgToUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushMsgToUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GateServer).PushMsgToUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Gate/PushMsgToUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GateServer).PushMsgToUser(ctx, req.(*PushMsgToUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gate_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GateServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Gate/KickUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GateServer).KickUser(ctx, req.(*KickUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gate_GetUsersOnlineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersOnlineStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GateServer).GetUsersOnlineStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Gate/GetUsersOnlineStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GateServer).GetUsersOnlineStatus(ctx, req.(*GetUsersOnlineStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Gate_ServiceDesc is the grpc.ServiceDesc for Gate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Gate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Gate",
+	HandlerType: (*GateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PushMsgToUser",
+			Handler:    _Gate_PushMsgToUser_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _Gate_KickUser_Handler,
+		},
+		{
+			MethodName: "GetUsersOnlineStatus",
+			Handler:    _Gate_GetUsersOnlineStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gate.proto",
+}
@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: group.proto
+
+package msg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GroupClient is the client API for Group service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupClient interface {
+	CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error)
+	DismissGroup(ctx context.Context, in *DismissGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	InviteUserToGroup(ctx context.Context, in *InviteUserToGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+	MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error)
+}
+
+type groupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupClient(cc grpc.ClientConnInterface) GroupClient {
+	return &groupClient{cc}
+}
+
+func (c *groupClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
+	out := new(CreateGroupResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) DismissGroup(ctx context.Context, in *DismissGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/DismissGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) InviteUserToGroup(ctx context.Context, in *InviteUserToGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/InviteUserToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/KickGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/QuitGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/TransferGroupOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/SetGroupMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/MuteGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*GroupCommonResp, error) {
+	out := new(GroupCommonResp)
+	err := c.cc.Invoke(ctx, "/proto.Group/MuteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServer is the server API for Group service.
+// All implementations must embed UnimplementedGroupServer
+// for forward compatibility
+type GroupServer interface {
+	CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error)
+	DismissGroup(context.Context, *DismissGroupReq) (*GroupCommonResp, error)
+	InviteUserToGroup(context.Context, *InviteUserToGroupReq) (*GroupCommonResp, error)
+	KickGroupMember(context.Context, *KickGroupMemberReq) (*GroupCommonResp, error)
+	QuitGroup(context.Context, *QuitGroupReq) (*GroupCommonResp, error)
+	TransferGroupOwner(context.Context, *TransferGroupOwnerReq) (*GroupCommonResp, error)
+	SetGroupMemberRole(context.Context, *SetGroupMemberRoleReq) (*GroupCommonResp, error)
+	MuteGroupMember(context.Context, *MuteGroupMemberReq) (*GroupCommonResp, error)
+	MuteGroup(context.Context, *MuteGroupReq) (*GroupCommonResp, error)
+	mustEmbedUnimplementedGroupServer()
+}
+
+// UnimplementedGroupServer must be embedded to have forward compatible implementations.
+type UnimplementedGroupServer struct {
+}
+
+func (UnimplementedGroupServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupServer) DismissGroup(context.Context, *DismissGroupReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissGroup not implemented")
+}
+func (UnimplementedGroupServer) InviteUserToGroup(context.Context, *InviteUserToGroupReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUserToGroup not implemented")
+}
+func (UnimplementedGroupServer) KickGroupMember(context.Context, *KickGroupMemberReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickGroupMember not implemented")
+}
+func (UnimplementedGroupServer) QuitGroup(context.Context, *QuitGroupReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuitGroup not implemented")
+}
+func (UnimplementedGroupServer) TransferGroupOwner(context.Context, *TransferGroupOwnerReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGroupOwner not implemented")
+}
+func (UnimplementedGroupServer) SetGroupMemberRole(context.Context, *SetGroupMemberRoleReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupMemberRole not implemented")
+}
+func (UnimplementedGroupServer) MuteGroupMember(context.Context, *MuteGroupMemberReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroupMember not implemented")
+}
+func (UnimplementedGroupServer) MuteGroup(context.Context, *MuteGroupReq) (*GroupCommonResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroup not implemented")
+}
+func (UnimplementedGroupServer) mustEmbedUnimplementedGroupServer() {}
+
+// UnsafeGroupServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServer will
+// result in compilation errors.
+type UnsafeGroupServer interface {
+	mustEmbedUnimplementedGroupServer()
+}
+
+func RegisterGroupServer(s grpc.ServiceRegistrar, srv GroupServer) {
+	s.RegisterService(&Group_ServiceDesc, srv)
+}
+
+func _Group_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).CreateGroup(ctx, req.(*CreateGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_DismissGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).DismissGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/DismissGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).DismissGroup(ctx, req.(*DismissGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_InviteUserToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserToGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).InviteUserToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/InviteUserToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).InviteUserToGroup(ctx, req.(*InviteUserToGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_KickGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickGroupMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).KickGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/KickGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).KickGroupMember(ctx, req.(*KickGroupMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_QuitGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuitGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).QuitGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/QuitGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).QuitGroup(ctx, req.(*QuitGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_TransferGroupOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGroupOwnerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).TransferGroupOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/TransferGroupOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).TransferGroupOwner(ctx, req.(*TransferGroupOwnerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/SetGroupMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, req.(*SetGroupMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_MuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).MuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/MuteGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).MuteGroupMember(ctx, req.(*MuteGroupMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_MuteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).MuteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Group/MuteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).MuteGroup(ctx, req.(*MuteGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Group_ServiceDesc is the grpc.ServiceDesc for Group service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Group_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Group",
+	HandlerType: (*GroupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Group_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DismissGroup",
+			Handler:    _Group_DismissGroup_Handler,
+		},
+		{
+			MethodName: "InviteUserToGroup",
+			Handler:    _Group_InviteUserToGroup_Handler,
+		},
+		{
+			MethodName: "KickGroupMember",
+			Handler:    _Group_KickGroupMember_Handler,
+		},
+		{
+			MethodName: "QuitGroup",
+			Handler:    _Group_QuitGroup_Handler,
+		},
+		{
+			MethodName: "TransferGroupOwner",
+			Handler:    _Group_TransferGroupOwner_Handler,
+		},
+		{
+			MethodName: "SetGroupMemberRole",
+			Handler:    _Group_SetGroupMemberRole_Handler,
+		},
+		{
+			MethodName: "MuteGroupMember",
+			Handler:    _Group_MuteGroupMember_Handler,
+		},
+		{
+			MethodName: "MuteGroup",
+			Handler:    _Group_MuteGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "group.proto",
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: token.proto
+
+package msg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TokenClient is the client API for Token service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TokenClient interface {
+	IssueToken(ctx context.Context, in *IssueTokenReq, opts ...grpc.CallOption) (*IssueTokenResp, error)
+	GetTokenState(ctx context.Context, in *GetTokenStateReq, opts ...grpc.CallOption) (*GetTokenStateResp, error)
+	KickToken(ctx context.Context, in *KickTokenReq, opts ...grpc.CallOption) (*KickTokenResp, error)
+}
+
+type tokenClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTokenClient(cc grpc.ClientConnInterface) TokenClient {
+	return &tokenClient{cc}
+}
+
+func (c *tokenClient) IssueToken(ctx context.Context, in *IssueTokenReq, opts ...grpc.CallOption) (*IssueTokenResp, error) {
+	out := new(IssueTokenResp)
+	err := c.cc.Invoke(ctx, "/proto.Token/IssueToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenClient) GetTokenState(ctx context.Context, in *GetTokenStateReq, opts ...grpc.CallOption) (*GetTokenStateResp, error) {
+	out := new(GetTokenStateResp)
+	err := c.cc.Invoke(ctx, "/proto.Token/GetTokenState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenClient) KickToken(ctx context.Context, in *KickTokenReq, opts ...grpc.CallOption) (*KickTokenResp, error) {
+	out := new(KickTokenResp)
+	err := c.cc.Invoke(ctx, "/proto.Token/KickToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TokenServer is the server API for Token service.
+// All implementations must embed UnimplementedTokenServer
+// for forward compatibility
+type TokenServer interface {
+	IssueToken(context.Context, *IssueTokenReq) (*IssueTokenResp, error)
+	GetTokenState(context.Context, *GetTokenStateReq) (*GetTokenStateResp, error)
+	KickToken(context.Context, *KickTokenReq) (*KickTokenResp, error)
+	mustEmbedUnimplementedTokenServer()
+}
+
+// UnimplementedTokenServer must be embedded to have forward compatible implementations.
+type UnimplementedTokenServer struct {
+}
+
+func (UnimplementedTokenServer) IssueToken(context.Context, *IssueTokenReq) (*IssueTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueToken not implemented")
+}
+func (UnimplementedTokenServer) GetTokenState(context.Context, *GetTokenStateReq) (*GetTokenStateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenState not implemented")
+}
+func (UnimplementedTokenServer) KickToken(context.Context, *KickTokenReq) (*KickTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickToken not implemented")
+}
+func (UnimplementedTokenServer) mustEmbedUnimplementedTokenServer() {}
+
+// UnsafeTokenServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TokenServer will
+// result in compilation errors.
+type UnsafeTokenServer interface {
+	mustEmbedUnimplementedTokenServer()
+}
+
+func RegisterTokenServer(s grpc.ServiceRegistrar, srv TokenServer) {
+	s.RegisterService(&Token_ServiceDesc, srv)
+}
+
+func _Token_IssueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServer).IssueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Token/IssueToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServer).IssueToken(ctx, req.(*IssueTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Token_GetTokenState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServer).GetTokenState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Token/GetTokenState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServer).GetTokenState(ctx, req.(*GetTokenStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Token_KickToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServer).KickToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Token/KickToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServer).KickToken(ctx, req.(*KickTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Token_ServiceDesc is the grpc.ServiceDesc for Token service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Token_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Token",
+	HandlerType: (*TokenServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueToken",
+			Handler:    _Token_IssueToken_Handler,
+		},
+		{
+			MethodName: "GetTokenState",
+			Handler:    _Token_GetTokenState_Handler,
+		},
+		{
+			MethodName: "KickToken",
+			Handler:    _Token_KickToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "token.proto",
+}
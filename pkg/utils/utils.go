@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"insight/pkg/common/constant"
+	"insight/pkg/proto/msg"
 	"math/rand"
 	"runtime"
 	"strconv"
@@ -16,7 +18,7 @@ func AsyncIgnored(log *logrus.Entry, handler func() error) {
 	go func() {
 		defer func() {
 			if v := recover(); v != nil {
-				log.Error("execute async handler panic: %v", v)
+				log.Errorf("execute async handler panic: %v", v)
 			}
 		}()
 		if err := handler(); err != nil {
@@ -54,6 +56,50 @@ func cleanUpFuncName(funcName string) string {
 	return funcName[end+1:]
 }
 
+// 获取消息选项开关，未设置时默认开启
+func GetSwitchFromOptions(options map[string]bool, key string) bool {
+	if flag, ok := options[key]; ok {
+		return flag
+	}
+	return true
+}
+
+// 会话id，单聊为"single_"+对方id，群聊为"group_"+群id
+func GetConversationIDBySessionType(sourceID string, sessionType int32) string {
+	switch sessionType {
+	case constant.SingleChatType:
+		return "single_" + sourceID
+	case constant.GroupChatType:
+		return "group_" + sourceID
+	}
+	return ""
+}
+
+// 解析会话id，返回会话类型和对方id或群id，格式不对时会话类型为0
+func ParseConversationID(conversationID string) (int32, string) {
+	for _, sessionType := range []int32{constant.SingleChatType, constant.GroupChatType} {
+		prefix := GetConversationIDBySessionType("", sessionType)
+		if strings.HasPrefix(conversationID, prefix) && len(conversationID) > len(prefix) {
+			return sessionType, conversationID[len(prefix):]
+		}
+	}
+	return 0, ""
+}
+
+// 消息在userID看来所属的会话id
+func GetConversationIDByMsg(data *msg.MsgData, userID string) string {
+	switch data.SessionType {
+	case constant.SingleChatType:
+		if data.SendID == userID {
+			return GetConversationIDBySessionType(data.RecvID, data.SessionType)
+		}
+		return GetConversationIDBySessionType(data.SendID, data.SessionType)
+	case constant.GroupChatType:
+		return GetConversationIDBySessionType(data.GroupID, data.SessionType)
+	}
+	return ""
+}
+
 func OperationIDGenerator() string {
 	return strconv.FormatInt(time.Now().UnixNano()+int64(rand.Uint32()), 10)
 }